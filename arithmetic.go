@@ -0,0 +1,92 @@
+package isodates
+
+import (
+	"time"
+
+	"github.com/snabb/isoweek"
+)
+
+/*
+ * All of the arithmetic is done using day numbers (Julian days) and month numbers rather than by
+ * adding durations to time.Time values, so there's no time zone or DST weirdness involved. It also
+ * means that stepping from 2020-W53 to 2021-W01 is no different than stepping from W05 to W06.
+ */
+
+// AddDays returns the date that is n days after this one (or before it if n is negative).
+func (date Date) AddDays(n int) Date {
+	year, month, day := isoweek.JulianToDate(date.dayNumber() + n)
+	return Date{Year: year, Month: month, Day: day}
+}
+
+// Weekday returns the day of the week that this date falls on.
+func (date Date) Weekday() time.Weekday {
+	// Julian day 0 was a Monday, so we need to shift by one to get time.Weekday's Sunday-based numbering.
+	return time.Weekday(mod(date.dayNumber()+1, 7))
+}
+
+// DaysBetween returns the number of days from date a to date b. The result is negative when b comes
+// before a.
+func DaysBetween(a Date, b Date) int {
+	return b.dayNumber() - a.dayNumber()
+}
+
+func (date Date) dayNumber() int {
+	return isoweek.DateToJulian(date.Year, date.Month, date.Day)
+}
+
+// Next returns the week immediately following this one.
+func (week Week) Next() Week {
+	return week.AddWeeks(1)
+}
+
+// Prev returns the week immediately before this one.
+func (week Week) Prev() Week {
+	return week.AddWeeks(-1)
+}
+
+// AddWeeks returns the week that is n weeks after this one (or before it if n is negative). The
+// result rolls over into the next/previous ISO week-numbering year as needed, taking years with
+// 53 weeks into account.
+func (week Week) AddWeeks(n int) Week {
+	year, month, day := isoweek.JulianToDate(week.dayNumber() + n*7)
+	weekYear, weekNum := ISOWeeks.FromDate(year, month, day)
+	return Week{Year: weekYear, Week: weekNum}
+}
+
+// WeeksBetween returns the number of weeks from week a to week b. The result is negative when b comes
+// before a. For example, there is 1 week between 2020-W53 and 2021-W01.
+func WeeksBetween(a Week, b Week) int {
+	return (b.dayNumber() - a.dayNumber()) / 7
+}
+
+// dayNumber returns the day number of the Monday that begins the week.
+func (week Week) dayNumber() int {
+	return isoweek.DateToJulian(ISOWeeks.StartDate(week.Year, week.Week))
+}
+
+// AddMonths returns the year/month that is n months after this one (or before it if n is negative).
+func (yearMonth YearMonth) AddMonths(n int) YearMonth {
+	monthNumber := yearMonth.monthNumber() + n
+	return YearMonth{Year: floorDiv(monthNumber, 12), Month: time.Month(mod(monthNumber, 12) + 1)}
+}
+
+// MonthsBetween returns the number of months from year/month a to year/month b. The result is negative
+// when b comes before a.
+func MonthsBetween(a YearMonth, b YearMonth) int {
+	return b.monthNumber() - a.monthNumber()
+}
+
+// monthNumber returns the number of months since January of year 0.
+func (yearMonth YearMonth) monthNumber() int {
+	return yearMonth.Year*12 + int(yearMonth.Month) - 1
+}
+
+// floorDiv divides a by b, rounding toward negative infinity rather than toward zero.
+func floorDiv(a int, b int) int {
+	return (a - mod(a, b)) / b
+}
+
+// mod returns the (always non-negative) remainder of a divided by b.
+func mod(a int, b int) int {
+	return ((a % b) + b) % b
+}
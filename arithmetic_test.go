@@ -0,0 +1,113 @@
+package isodates_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestArithmeticSuite(t *testing.T) {
+	suite.Run(t, new(ArithmeticSuite))
+}
+
+type ArithmeticSuite struct {
+	ChronoSuite
+}
+
+func (suite *ArithmeticSuite) TestAddDays() {
+	date := func(year int, month time.Month, day int) isodates.Date {
+		return isodates.Date{Year: year, Month: month, Day: day}
+	}
+	check := func(start isodates.Date, n int, expected isodates.Date) {
+		actual := start.AddDays(n)
+		_ = suite.Equal(expected, actual, "%v + %d", start, n) &&
+			suite.Equal(n, isodates.DaysBetween(start, actual), "%v - %v", actual, start)
+	}
+	check(date(2019, time.May, 22), 0, date(2019, time.May, 22))
+	check(date(2019, time.May, 22), 1, date(2019, time.May, 23))
+	check(date(2019, time.May, 31), 1, date(2019, time.June, 1))
+	check(date(2019, time.December, 31), 1, date(2020, time.January, 1))
+	check(date(2020, time.February, 28), 1, date(2020, time.February, 29))
+	check(date(2019, time.February, 28), 1, date(2019, time.March, 1))
+	check(date(2019, time.January, 1), -1, date(2018, time.December, 31))
+	check(date(2019, time.May, 22), 365, date(2020, time.May, 21))
+	check(date(2019, time.May, 22), -10000, date(1992, time.January, 4))
+}
+
+func (suite *ArithmeticSuite) TestWeekday() {
+	check := func(year int, month time.Month, day int) {
+		expected := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday()
+		actual := isodates.Date{Year: year, Month: month, Day: day}.Weekday()
+		suite.Equal(expected, actual, "%d-%02d-%02d", year, month, day)
+	}
+	check(2019, time.May, 22)
+	check(2019, time.May, 26)
+	check(2019, time.May, 27)
+	check(2020, time.February, 29)
+	check(2000, time.January, 1)
+	check(1970, time.January, 1)
+	check(1, time.January, 1)
+	check(-100, time.March, 15)
+}
+
+func (suite *ArithmeticSuite) TestAddWeeks() {
+	week := func(year int, num int) isodates.Week {
+		return isodates.Week{Year: year, Week: num}
+	}
+	check := func(start isodates.Week, n int, expected isodates.Week) {
+		actual := start.AddWeeks(n)
+		_ = suite.Equal(expected, actual, "%v + %d", start, n) &&
+			suite.Equal(n, isodates.WeeksBetween(start, actual), "%v - %v", actual, start)
+	}
+	check(week(2019, 5), 0, week(2019, 5))
+	check(week(2019, 5), 1, week(2019, 6))
+	check(week(2019, 52), 1, week(2020, 1))
+	check(week(2020, 52), 1, week(2020, 53))
+	check(week(2020, 53), 1, week(2021, 1))
+	check(week(2021, 1), -1, week(2020, 53))
+	check(week(2020, 1), -1, week(2019, 52))
+	check(week(2019, 5), 52, week(2020, 5))
+	check(week(2020, 5), 52, week(2021, 4))
+	check(week(2019, 5), -261, week(2014, 5))
+
+	suite.Equal(week(2021, 1), week(2020, 53).Next())
+	suite.Equal(week(2020, 53), week(2021, 1).Prev())
+	suite.Equal(week(2019, 4), week(2019, 5).Prev())
+	suite.Equal(1, isodates.WeeksBetween(week(2020, 53), week(2021, 1)))
+	suite.Equal(-1, isodates.WeeksBetween(week(2021, 1), week(2020, 53)))
+}
+
+func (suite *ArithmeticSuite) TestAddMonths() {
+	yearMonth := func(year int, month time.Month) isodates.YearMonth {
+		return isodates.YearMonth{Year: year, Month: month}
+	}
+	check := func(start isodates.YearMonth, n int, expected isodates.YearMonth) {
+		actual := start.AddMonths(n)
+		_ = suite.Equal(expected, actual, "%v + %d", start, n) &&
+			suite.Equal(n, isodates.MonthsBetween(start, actual), "%v - %v", actual, start)
+	}
+	check(yearMonth(2019, time.May), 0, yearMonth(2019, time.May))
+	check(yearMonth(2019, time.May), 1, yearMonth(2019, time.June))
+	check(yearMonth(2019, time.December), 1, yearMonth(2020, time.January))
+	check(yearMonth(2019, time.January), -1, yearMonth(2018, time.December))
+	check(yearMonth(2019, time.May), 12, yearMonth(2020, time.May))
+	check(yearMonth(2019, time.May), -17, yearMonth(2017, time.December))
+	check(yearMonth(2019, time.May), 100, yearMonth(2027, time.September))
+	check(yearMonth(0, time.January), -1, yearMonth(-1, time.December))
+	check(yearMonth(-1, time.December), 13, yearMonth(1, time.January))
+}
+
+func ExampleWeek_AddWeeks() {
+	week := isodates.Week{Year: 2020, Week: 52}
+	fmt.Println(week.Next())
+	fmt.Println(week.AddWeeks(2))
+	fmt.Println(isodates.WeeksBetween(week, isodates.Week{Year: 2021, Week: 5}))
+
+	// Output:
+	// 2020-W53
+	// 2021-W01
+	// 6
+}
@@ -0,0 +1,119 @@
+package isodates
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/snabb/isoweek"
+)
+
+/*
+ * The broadcast calendar used by the media industry is made up entirely of ISO weeks (Monday
+ * through Sunday). A broadcast month starts on the Monday of the week containing the 1st of the
+ * month and ends on the last Sunday of the month, so every month has either 4 or 5 whole weeks.
+ */
+
+// ParseBroadcastMonth accepts a broadcast month string (e.g. "2019-05") and returns the year and
+// month that it represents. This is the same format as an ISO year/month.
+func ParseBroadcastMonth(input string) (int, time.Month, error) {
+	return ParseYearMonth(input)
+}
+
+// ParseBroadcastMonthStart returns midnight on the Monday that begins the broadcast month string
+// (e.g. "2019-05"). The resulting date/time will be in UTC.
+func ParseBroadcastMonthStart(input string) (time.Time, error) {
+	return ParseBroadcastMonthStartIn(input, time.UTC)
+}
+
+// ParseBroadcastMonthStartIn returns midnight on the Monday that begins the broadcast month string
+// (e.g. "2019-05"). The resulting date/time will be in the specified time zone.
+func ParseBroadcastMonthStartIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse broadcast month start: %w", ErrNilLocation)
+	}
+	year, month, err := ParseBroadcastMonth(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	startYear, startMonth, startDay := broadcastMonthStart(year, month)
+	return Midnight(startYear, startMonth, startDay, loc), nil
+}
+
+// ParseBroadcastMonthEnd returns 11:59:59pm on the Sunday that ends the broadcast month string
+// (e.g. "2019-05"). The resulting date/time will be in UTC.
+func ParseBroadcastMonthEnd(input string) (time.Time, error) {
+	return ParseBroadcastMonthEndIn(input, time.UTC)
+}
+
+// ParseBroadcastMonthEndIn returns 11:59:59pm on the Sunday that ends the broadcast month string
+// (e.g. "2019-05"). The resulting date/time will be in the specified time zone.
+func ParseBroadcastMonthEndIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse broadcast month end: %w", ErrNilLocation)
+	}
+	year, month, err := ParseBroadcastMonth(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	// The month ends the day before the next broadcast month begins.
+	nextYear, nextMonth, nextDay := broadcastMonthStart(year, month+1)
+	return AlmostMidnight(nextYear, nextMonth, nextDay-1, loc), nil
+}
+
+// ParseBroadcastMonthEndExclusive returns midnight on the Monday after the broadcast month string
+// (e.g. "2019-05") ends, which is when the next broadcast month begins. The resulting date/time will be
+// in UTC.
+func ParseBroadcastMonthEndExclusive(input string) (time.Time, error) {
+	return ParseBroadcastMonthEndExclusiveIn(input, time.UTC)
+}
+
+// ParseBroadcastMonthEndExclusiveIn returns midnight on the Monday after the broadcast month string
+// (e.g. "2019-05") ends. The resulting date/time will be in the specified time zone.
+func ParseBroadcastMonthEndExclusiveIn(input string, loc *time.Location) (time.Time, error) {
+	return exclusiveEnd(ParseBroadcastMonthEndIn(input, loc))
+}
+
+// ParseBroadcastMonthRange returns the range from midnight on the Monday that begins the broadcast month
+// string (e.g. "2019-05") through 11:59:59pm on the Sunday that ends it. The resulting range will be in UTC.
+func ParseBroadcastMonthRange(input string) (Range, error) {
+	return ParseBroadcastMonthRangeIn(input, time.UTC)
+}
+
+// ParseBroadcastMonthRangeIn returns the range from midnight on the Monday that begins the broadcast month
+// string (e.g. "2019-05") through 11:59:59pm on the Sunday that ends it. The resulting range will be in
+// the specified time zone.
+func ParseBroadcastMonthRangeIn(input string, loc *time.Location) (Range, error) {
+	if loc == nil {
+		return Range{}, fmt.Errorf("parse broadcast month range: %w", ErrNilLocation)
+	}
+	year, month, err := ParseBroadcastMonth(input)
+	if err != nil {
+		return Range{}, err
+	}
+	startYear, startMonth, startDay := broadcastMonthStart(year, month)
+	nextYear, nextMonth, nextDay := broadcastMonthStart(year, month+1)
+	return Range{
+		Start: Midnight(startYear, startMonth, startDay, loc),
+		End:   AlmostMidnight(nextYear, nextMonth, nextDay-1, loc),
+	}, nil
+}
+
+// BroadcastPeriod returns the broadcast year and month that the given date/time falls in as well as
+// the week number within that broadcast year (week 1 is the week containing January 1st). The calendar
+// date is taken from the date/time in its own location, so convert it using time.In() first if you
+// want the broadcast period for some other time zone.
+func BroadcastPeriod(date time.Time) (year int, month time.Month, week int) {
+	// A broadcast week belongs to the month that its Sunday falls in.
+	monday := isoweek.DateToJulian(isoweek.StartDate(isoweek.FromDate(date.Date())))
+	year, month, _ = isoweek.JulianToDate(monday + 6)
+
+	yearStart := isoweek.DateToJulian(broadcastMonthStart(year, time.January))
+	return year, month, (monday-yearStart)/7 + 1
+}
+
+// broadcastMonthStart returns the date of the Monday that begins the given broadcast month. The
+// month is normalized, so you can pass in month 13 to get January of the following year.
+func broadcastMonthStart(year int, month time.Month) (int, time.Month, int) {
+	first := Midnight(year, month, 1, time.UTC)
+	return isoweek.StartDate(isoweek.FromDate(first.Date()))
+}
@@ -0,0 +1,183 @@
+package isodates_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestBroadcastSuite(t *testing.T) {
+	suite.Run(t, new(BroadcastSuite))
+}
+
+type BroadcastSuite struct {
+	ChronoSuite
+}
+
+func (suite *BroadcastSuite) TestParseBroadcastMonth() {
+	succeeds := func(input string, expectedYear int, expectedMonth time.Month) {
+		year, month, err := isodates.ParseBroadcastMonth(input)
+		_ = suite.NoError(err) &&
+			suite.Equal(expectedYear, year) &&
+			suite.Equal(expectedMonth, month)
+	}
+	fails := func(input string) {
+		_, _, err := isodates.ParseBroadcastMonth(input)
+		suite.Error(err)
+	}
+
+	// TestParseYearMonth runs through all formats, so just make sure failure bubbles up.
+	fails("")
+	fails("not valid")
+	fails("2019-13")
+
+	succeeds("2019-05", 2019, time.May)
+	succeeds("2019-12", 2019, time.December)
+}
+
+func (suite *BroadcastSuite) TestParseBroadcastMonthStart() {
+	succeeds := func(input string, year int, month time.Month, day int) {
+		date, err := isodates.ParseBroadcastMonthStart(input)
+		suite.AssertMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(input string) {
+		_, err := isodates.ParseBroadcastMonthStart(input)
+		suite.Error(err)
+	}
+	fails("")
+	fails("not valid")
+
+	succeeds("2019-01", 2018, time.December, 31)
+	succeeds("2019-05", 2019, time.April, 29)
+	succeeds("2019-06", 2019, time.May, 27)
+	succeeds("2020-01", 2019, time.December, 30)
+	succeeds("2018-10", 2018, time.October, 1) // the 1st is a Monday
+}
+
+func (suite *BroadcastSuite) TestParseBroadcastMonthStartIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := isodates.ParseBroadcastMonthStartIn(input, loc)
+		suite.AssertMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.ParseBroadcastMonthStartIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", locationEDT)
+	fails("not valid", locationEDT)
+	fails("2019-05", nil)
+
+	succeeds("2019-05", locationEDT, 2019, time.April, 29)
+	succeeds("2019-05", locationPDT, 2019, time.April, 29)
+	succeeds("2020-01", locationEDT, 2019, time.December, 30)
+}
+
+func (suite *BroadcastSuite) TestParseBroadcastMonthEnd() {
+	succeeds := func(input string, year int, month time.Month, day int) {
+		date, err := isodates.ParseBroadcastMonthEnd(input)
+		suite.AssertAlmostMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(input string) {
+		_, err := isodates.ParseBroadcastMonthEnd(input)
+		suite.Error(err)
+	}
+	fails("")
+	fails("not valid")
+
+	succeeds("2019-01", 2019, time.January, 27)
+	succeeds("2019-05", 2019, time.May, 26)
+	succeeds("2019-06", 2019, time.June, 30) // the 30th is a Sunday
+	succeeds("2019-12", 2019, time.December, 29)
+	succeeds("2018-12", 2018, time.December, 30)
+}
+
+func (suite *BroadcastSuite) TestParseBroadcastMonthEndIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := isodates.ParseBroadcastMonthEndIn(input, loc)
+		suite.AssertAlmostMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.ParseBroadcastMonthEndIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", locationEDT)
+	fails("not valid", locationEDT)
+	fails("2019-05", nil)
+
+	succeeds("2019-05", locationEDT, 2019, time.May, 26)
+	succeeds("2019-05", locationPDT, 2019, time.May, 26)
+	succeeds("2019-12", locationEDT, 2019, time.December, 29)
+}
+
+func (suite *BroadcastSuite) TestParseBroadcastMonthEndExclusiveIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := isodates.ParseBroadcastMonthEndExclusiveIn(input, loc)
+		suite.AssertMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.ParseBroadcastMonthEndExclusiveIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", locationEDT)
+	fails("2019-05", nil)
+
+	succeeds("2019-05", locationEDT, 2019, time.May, 27)
+	succeeds("2019-12", locationPDT, 2019, time.December, 30)
+
+	date, err := isodates.ParseBroadcastMonthEndExclusive("2019-05")
+	suite.AssertMidnightUTC(date, err, 2019, time.May, 27)
+}
+
+func (suite *BroadcastSuite) TestParseBroadcastMonthRangeIn() {
+	succeeds := func(input string, loc *time.Location, startYear int, startMonth time.Month, startDay int, endYear int, endMonth time.Month, endDay int) {
+		r, err := isodates.ParseBroadcastMonthRangeIn(input, loc)
+		suite.AssertRangeIn(r, err, startYear, startMonth, startDay, endYear, endMonth, endDay, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.ParseBroadcastMonthRangeIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", locationEDT)
+	fails("not valid", locationEDT)
+	fails("2019-05", nil)
+
+	succeeds("2019-05", locationEDT, 2019, time.April, 29, 2019, time.May, 26)
+	succeeds("2019-12", locationPDT, 2019, time.November, 25, 2019, time.December, 29)
+	succeeds("2020-01", locationEDT, 2019, time.December, 30, 2020, time.January, 26)
+
+	r, err := isodates.ParseBroadcastMonthRange("2019-05")
+	suite.AssertRangeIn(r, err, 2019, time.April, 29, 2019, time.May, 26, time.UTC)
+}
+
+func (suite *BroadcastSuite) TestBroadcastPeriod() {
+	check := func(date time.Time, expectedYear int, expectedMonth time.Month, expectedWeek int) {
+		year, month, week := isodates.BroadcastPeriod(date)
+		suite.Equal(expectedYear, year, "incorrect year")
+		suite.Equal(expectedMonth, month, "incorrect month")
+		suite.Equal(expectedWeek, week, "incorrect week")
+	}
+
+	check(time.Date(2018, time.December, 30, 23, 0, 0, 0, time.UTC), 2018, time.December, 52)
+	check(time.Date(2018, time.December, 31, 0, 0, 0, 0, time.UTC), 2019, time.January, 1)
+	check(time.Date(2019, time.January, 6, 0, 0, 0, 0, time.UTC), 2019, time.January, 1)
+	check(time.Date(2019, time.January, 7, 0, 0, 0, 0, time.UTC), 2019, time.January, 2)
+	check(time.Date(2019, time.April, 29, 0, 0, 0, 0, time.UTC), 2019, time.May, 18)
+	check(time.Date(2019, time.April, 28, 0, 0, 0, 0, time.UTC), 2019, time.April, 17)
+	check(time.Date(2019, time.June, 30, 0, 0, 0, 0, time.UTC), 2019, time.June, 26)
+	check(time.Date(2019, time.December, 30, 0, 0, 0, 0, time.UTC), 2020, time.January, 1)
+
+	// The calendar date comes from the time's own location.
+	check(time.Date(2019, time.April, 28, 22, 0, 0, 0, locationPDT), 2019, time.April, 17)
+	check(time.Date(2019, time.April, 28, 22, 0, 0, 0, locationPDT).UTC(), 2019, time.May, 18)
+}
+
+func ExampleParseBroadcastMonthStart() {
+	start, _ := isodates.ParseBroadcastMonthStart("2019-05")
+	end, _ := isodates.ParseBroadcastMonthEnd("2019-05")
+	fmt.Println(start.Format("Jan 2, 2006") + " - " + end.Format("Jan 2, 2006"))
+
+	// Output: Apr 29, 2019 - May 26, 2019
+}
@@ -1,8 +1,7 @@
 package isodates
 
 import (
-	"errors"
-	"fmt"
+	"math"
 	"strconv"
 	"time"
 )
@@ -14,57 +13,260 @@ const ZeroMonth = time.Month(0)
 var ZeroTime = time.Time{}
 
 // Midnight creates a date/time instance in the given time zone that is exactly midnight
-// on the specified date.
+// on the specified date. In time zones where DST starts at midnight (e.g. America/Sao_Paulo
+// before 2019), midnight doesn't exist on that day, so you get the first instant that does
+// exist on that calendar date instead (e.g. 1:00am).
 func Midnight(year int, month time.Month, day int, loc *time.Location) time.Time {
-	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	// Let time.Date() normalize things like day 0 or day 32 before we compare against them.
+	year, month, day = time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Date()
+
+	midnight := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	if hour, min, sec := midnight.Clock(); hour == 0 && min == 0 && sec == 0 && midnight.Nanosecond() == 0 {
+		return midnight
+	}
+
+	// Midnight fell into a DST gap, so time.Date() moved it to some other time (possibly even on the
+	// previous day). The day actually starts at the first instant whose local date is the one we want,
+	// so binary search for it; DST gaps are never more than a few hours, let alone a day.
+	low := midnight.Add(-24 * time.Hour)
+	high := midnight.Add(24 * time.Hour)
+	for high.Sub(low) > time.Nanosecond {
+		mid := low.Add(high.Sub(low) / 2)
+		if onOrAfter(mid, year, month, day) {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+	return high
 }
 
 // AlmostMidnight creates a date/time instance in the given time zone that is exactly
-// 11:59:59pm on the specified date.
+// 11:59:59pm on the specified date (one EndPrecision before midnight). It's based on when the
+// next day actually starts, so it's the true last instant of the day even when DST shifts the
+// clocks around midnight.
 func AlmostMidnight(year int, month time.Month, day int, loc *time.Location) time.Time {
-	return time.Date(year, month, day, 23, 59, 59, 999999999, loc)
+	return Midnight(year, month, day+1, loc).Add(-endPrecision())
+}
+
+// onOrAfter returns true if the calendar date of the date/time is on or after the given date.
+func onOrAfter(date time.Time, year int, month time.Month, day int) bool {
+	y, m, d := date.Date()
+	switch {
+	case y != year:
+		return y > year
+	case m != month:
+		return m > month
+	default:
+		return d >= day
+	}
+}
+
+// EndPrecision is the precision of the 11:59:59pm times returned by AlmostMidnight() and every
+// one of the End functions. By default they're one nanosecond before midnight (23:59:59.999999999),
+// but databases such as MySQL DATETIME(6) and Postgres only store microseconds, so they round that
+// up into the next day. Set this to time.Second, time.Millisecond, or time.Microsecond to get
+// 23:59:59, 23:59:59.999, or 23:59:59.999999 instead. Values outside of (0, time.Second] are treated
+// as time.Nanosecond. Set it once when your program starts up; it's not safe to change concurrently.
+var EndPrecision = time.Nanosecond
+
+// endPrecision returns the EndPrecision setting, falling back to nanoseconds if it's not valid.
+func endPrecision() time.Duration {
+	if EndPrecision <= 0 || EndPrecision > time.Second {
+		return time.Nanosecond
+	}
+	return EndPrecision
+}
+
+// exclusiveEnd converts the result of one of the EndIn functions (11:59:59pm on the last day) into
+// midnight on the following day. We go through the calendar date rather than adding a nanosecond so
+// the result is always the real start of the next day in that time zone.
+func exclusiveEnd(end time.Time, err error) (time.Time, error) {
+	if err != nil {
+		return ZeroTime, err
+	}
+	year, month, day := end.Date()
+	return Midnight(year, month, day+1, end.Location()), nil
+}
+
+// instantAfter returns the exclusive end that goes with an inclusive one (i.e. the first instant after
+// it). An 11:59:59pm end from AlmostMidnight() is within a second of the next day's midnight no matter
+// which EndPrecision it was built with, so those go through the calendar just like exclusiveEnd(). Any
+// other end is one EndPrecision later.
+func instantAfter(end time.Time) time.Time {
+	midnight, _ := exclusiveEnd(end, nil)
+	if gap := midnight.Sub(end); gap > 0 && gap <= time.Second {
+		return midnight
+	}
+	return end.Add(endPrecision())
+}
+
+// instantBefore is the reverse of instantAfter(); it returns the inclusive end that goes with an exclusive
+// one. An exclusive end at midnight becomes 11:59:59pm on the previous day.
+func instantBefore(end time.Time) time.Time {
+	if isMidnight(end) {
+		year, month, day := end.Date()
+		return AlmostMidnight(year, month, day-1, end.Location())
+	}
+	return end.Add(-endPrecision())
+}
+
+// isMidnight returns true if the date/time is the first instant of its day in its own location.
+func isMidnight(t time.Time) bool {
+	year, month, day := t.Date()
+	return Midnight(year, month, day, t.Location()).Equal(t)
+}
+
+// DaysInMonth returns the number of days in the given month of the given year, taking leap
+// years into account (e.g. February 2000 has 29 days, but February 2019 has 28).
+func DaysInMonth(year int, month time.Month) int {
+	// Day 0 of the following month is the last day of this one.
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// parseYear parses the unsigned year found at input[start:end]. Years have no particular range, so this
+// only makes sure that it's all digits; use parseSignedYear() for expanded years such as "+10000".
+func parseYear(format string, input string, start int, end int) (int, error) {
+	if !isDigits(input[start:end]) {
+		return 0, componentError(format, input, ComponentYear, start, end, ReasonNotNumeric)
+	}
+	return parseSignedYear(format, input, start, end)
 }
 
-func parseYear(input string) (int, error) {
-	year, err := strconv.ParseInt(input, 10, 64)
+// parseSignedYear parses the year found at input[start:end], which may start with a '+' or '-' sign.
+func parseSignedYear(format string, input string, start int, end int) (int, error) {
+	digitStart := start
+	if end > start && (input[start] == '+' || input[start] == '-') {
+		digitStart++
+	}
+	// Don't let strconv accept a second sign such as "+-2019".
+	if !isDigits(input[digitStart:end]) {
+		return 0, componentError(format, input, ComponentYear, start, end, ReasonNotNumeric)
+	}
+	year, err := strconv.ParseInt(input[start:end], 10, 64)
 	if err != nil {
-		return 0, errors.New("invalid year: " + input)
+		return 0, componentError(format, input, ComponentYear, start, end, ReasonNotNumeric)
 	}
 	return int(year), nil
 }
 
-func parseMonth(input string) (time.Month, error) {
-	month, err := strconv.ParseInt(input, 10, 64)
-	if err != nil || month < 1 || month > 12 {
-		return ZeroMonth, errors.New("invalid month: " + input)
+func parseMonth(format string, input string, start int, end int) (time.Month, error) {
+	month, err := parseNumber(format, input, start, end, ComponentMonth, 1, 12)
+	return time.Month(month), err
+}
+
+// parseDayOfMonth only requires the day to be positive. Callers that care whether the day actually
+// exists in the month need to check that themselves.
+func parseDayOfMonth(format string, input string, start int, end int) (int, error) {
+	return parseNumber(format, input, start, end, ComponentDay, 1, math.MaxInt32)
+}
+
+func parseDayOfYear(format string, input string, start int, end int) (int, error) {
+	return parseNumber(format, input, start, end, ComponentDayOfYear, 1, 366)
+}
+
+func parseWeek(format string, input string, start int, end int) (int, error) {
+	return parseNumber(format, input, start, end, ComponentWeek, 1, 53)
+}
+
+func parseQuarter(format string, input string, start int, end int) (int, error) {
+	return parseNumber(format, input, start, end, ComponentQuarter, 1, 4)
+}
+
+func parsePeriod(format string, input string, start int, end int) (int, error) {
+	return parseNumber(format, input, start, end, ComponentPeriod, 1, 12)
+}
+
+func parseWeekOffset(format string, input string, start int, end int) (int, error) {
+	return parseNumber(format, input, start, end, ComponentOffset, 1, 7)
+}
+
+// parseNumber parses the component found at input[start:end] and makes sure that it's between min and max.
+func parseNumber(format string, input string, start int, end int, component Component, min int, max int) (int, error) {
+	value, err := strconv.ParseInt(input[start:end], 10, 64)
+	switch {
+	case err != nil || !isDigits(input[start:end]):
+		// None of these components are signed, so don't let strconv accept a sign such as "--+1-25".
+		return 0, componentError(format, input, component, start, end, ReasonNotNumeric)
+	case value < int64(min) || value > int64(max):
+		return 0, componentError(format, input, component, start, end, ReasonOutOfRange)
+	}
+	return int(value), nil
+}
+
+// yearLayout describes which of the ISO representations an input that starts with a year uses: the
+// extended one with '-' separators (e.g. "2019-05-22") or the basic one without them (e.g. "20190522"),
+// and whether the year is the usual 4 digits or an expanded one with a sign (e.g. "+002019-05-22").
+type yearLayout struct {
+	// format is the format we report in errors (e.g. "YYYYMMDD" or "[+-]YYYY-MM-DD").
+	format string
+	// yearEnd is the index just past the last digit of the year.
+	yearEnd int
+	// basic is true when the input leaves out the '-' separators.
+	basic bool
+}
+
+// layoutOf works out the layout of the input given the extended and basic versions of its format with
+// a 4-digit year (e.g. "YYYY-MM-DD" and "YYYYMMDD"). Everything after the year has a fixed length, so
+// we find the end of an expanded year by counting back from the end of the input.
+func layoutOf(input string, extended string, basic string) yearLayout {
+	if input == "" || (input[0] != '+' && input[0] != '-') {
+		// Only treat it as basic if the component after the year starts right away (e.g. "2019W05"
+		// or "20190522"), so typos such as "2019.5.3" still get reported against the usual format.
+		if len(input) == len(basic) && (isDigit(input[4]) || input[4] == 'W') {
+			return yearLayout{format: basic, yearEnd: 4, basic: true}
+		}
+		return yearLayout{format: extended, yearEnd: 4}
 	}
-	return time.Month(month), nil
+	if yearEnd := len(input) - len(extended) + 4; yearEnd > 0 && input[yearEnd] == '-' {
+		return yearLayout{format: "[+-]" + extended, yearEnd: yearEnd}
+	}
+	return yearLayout{format: "[+-]" + basic, yearEnd: len(input) - len(basic) + 4, basic: true}
+}
+
+// expanded returns true if the year has a sign (e.g. "+10000" or "-0044").
+func (layout yearLayout) expanded() bool {
+	return layout.format[0] == '['
 }
 
-func parseDayOfMonth(input string) (int, error) {
-	day, err := strconv.ParseInt(input, 10, 64)
-	if err != nil || day < 1 {
-		return 0, errors.New("invalid day of month: " + input)
+// separator returns the separator that goes between the components ("-" or nothing in basic format).
+func (layout yearLayout) separator() string {
+	if layout.basic {
+		return ""
+	}
+	return "-"
+}
+
+// checkLength makes sure that the input is long enough to hold the layout. Expanded years need a sign
+// and at least 4 digits; otherwise the input has to be exactly as long as the format.
+func (layout yearLayout) checkLength(input string) error {
+	if !layout.expanded() {
+		return checkLength(layout.format, input, len(layout.format))
+	}
+	if layout.yearEnd < 5 {
+		return formatError(layout.format, input, len(input))
 	}
-	return int(day), nil
+	return nil
 }
 
-func parseWeek(input string) (int, error) {
-	week, err := strconv.ParseInt(input, 10, 64)
-	if err != nil || week < 1 || week > 53 {
-		return 0, errors.New("invalid week number: " + input)
+// parseYear parses the year at the start of the input, including the sign of an expanded year.
+func (layout yearLayout) parseYear(input string) (int, error) {
+	if layout.expanded() {
+		return parseSignedYear(layout.format, input, 0, layout.yearEnd)
 	}
-	return int(week), nil
+	return parseYear(layout.format, input, 0, layout.yearEnd)
 }
 
-func parseWeekOffset(input string) (int, error) {
-	offset, err := strconv.ParseInt(input, 10, 64)
-	if err != nil || offset < 1 || offset > 7 {
-		return 0, errors.New("invalid week offset: " + input)
+// isDigits returns true if the value is made up of only ASCII digits.
+func isDigits(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if !isDigit(value[i]) {
+			return false
+		}
 	}
-	return int(offset), nil
+	return true
 }
 
-func invalidFormat(format string, input string) error {
-	return fmt.Errorf("invalid %s format: %s", format, input)
+func isDigit(ch byte) bool {
+	return ch >= '0' && ch <= '9'
 }
@@ -1,13 +1,18 @@
 package isodates_test
 
 import (
+	"testing"
 	"time"
 
+	"github.com/robsignorelli/isodates"
 	"github.com/stretchr/testify/suite"
 )
 
 var locationEDT, _ = time.LoadLocation("America/New_York")
 var locationPDT, _ = time.LoadLocation("America/Los_Angeles")
+var locationSaoPaulo, _ = time.LoadLocation("America/Sao_Paulo")
+var locationHavana, _ = time.LoadLocation("America/Havana")
+var locationBeirut, _ = time.LoadLocation("Asia/Beirut")
 
 type ChronoSuite struct {
 	suite.Suite
@@ -81,3 +86,135 @@ func (suite ChronoSuite) AssertAlmostMidnightIn(date time.Time, err error, year
 		suite.Equal(999999999, date.Nanosecond(), "incorrect nanos") &&
 		suite.Equal(loc, date.Location(), "incorrect location")
 }
+
+// AssertRangeIn ensures that there's no error and that the range goes from midnight on the start date through
+// 11:59:59pm on the end date. Both times should be in the specified location/zone.
+func (suite ChronoSuite) AssertRangeIn(r isodates.Range, err error, startYear int, startMonth time.Month, startDay int, endYear int, endMonth time.Month, endDay int, loc *time.Location) bool {
+	return suite.AssertMidnightIn(r.Start, err, startYear, startMonth, startDay, loc) &&
+		suite.AssertAlmostMidnightIn(r.End, err, endYear, endMonth, endDay, loc)
+}
+
+func TestCoreSuite(t *testing.T) {
+	suite.Run(t, new(CoreSuite))
+}
+
+type CoreSuite struct {
+	ChronoSuite
+}
+
+func (suite *CoreSuite) TestEndPrecision() {
+	defer func() { isodates.EndPrecision = time.Nanosecond }()
+
+	nanos := func(precision time.Duration) int {
+		isodates.EndPrecision = precision
+		return isodates.AlmostMidnight(2019, time.May, 22, time.UTC).Nanosecond()
+	}
+	suite.Equal(999999999, nanos(time.Nanosecond))
+	suite.Equal(999999000, nanos(time.Microsecond))
+	suite.Equal(999000000, nanos(time.Millisecond))
+	suite.Equal(0, nanos(time.Second))
+
+	// Nonsense values fall back to nanoseconds.
+	suite.Equal(999999999, nanos(0))
+	suite.Equal(999999999, nanos(-time.Microsecond))
+	suite.Equal(999999999, nanos(time.Minute))
+
+	// All of the End functions honor it.
+	isodates.EndPrecision = time.Microsecond
+	end, err := isodates.ParseWeekEndIn("2019-W05", locationEDT)
+	suite.AssertTime(end, err, 2019, time.February, 3, 23, 59, 59, 999999000)
+
+	end, err = isodates.NRFRetailCalendar.ParseRetailPeriodEnd("2019-P01")
+	suite.AssertTime(end, err, 2019, time.March, 2, 23, 59, 59, 999999000)
+
+	r, err := isodates.ParseDateRange("2019-05-22")
+	_ = suite.AssertTime(r.End, err, 2019, time.May, 22, 23, 59, 59, 999999000) &&
+		suite.True(r.HalfOpen().End.Equal(isodates.Midnight(2019, time.May, 23, time.UTC)))
+
+	// Back to back ranges are still adjacent.
+	next, _ := isodates.ParseDateRange("2019-05-23")
+	_, ok := r.Union(next)
+	suite.True(ok)
+
+	// Survives a round trip through microsecond storage without rolling into the next day.
+	stored := end.Round(time.Microsecond)
+	suite.Equal(end, stored)
+}
+
+func (suite *CoreSuite) TestMidnight() {
+	succeeds := func(loc *time.Location, year int, month time.Month, day int, expected time.Time) {
+		actual := isodates.Midnight(year, month, day, loc)
+		_ = suite.True(expected.Equal(actual), "expected %v, got %v", expected, actual) &&
+			suite.Equal(loc, actual.Location())
+	}
+	utc := func(year int, month time.Month, day int, hour int) time.Time {
+		return time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	// Normal days are unaffected, and we still normalize days outside of the month.
+	succeeds(time.UTC, 2019, time.May, 22, utc(2019, time.May, 22, 0))
+	succeeds(time.UTC, 2019, time.May, 32, utc(2019, time.June, 1, 0))
+	succeeds(locationEDT, 2019, time.March, 10, utc(2019, time.March, 10, 5))
+	succeeds(locationEDT, 2019, time.March, 11, utc(2019, time.March, 11, 4))
+
+	// DST started at midnight, so the day started at 1am (-02:00).
+	succeeds(locationSaoPaulo, 2018, time.November, 4, utc(2018, time.November, 4, 3))
+	succeeds(locationHavana, 2019, time.March, 10, utc(2019, time.March, 10, 5))
+	succeeds(locationBeirut, 2019, time.March, 31, utc(2019, time.March, 30, 22))
+
+	// DST ended at midnight, so the clocks went back to 11pm on the previous day; the new day's
+	// midnight still only happens once.
+	succeeds(locationSaoPaulo, 2019, time.February, 17, utc(2019, time.February, 17, 3))
+	succeeds(locationBeirut, 2019, time.October, 27, utc(2019, time.October, 26, 22))
+
+	start := isodates.Midnight(2018, time.November, 4, locationSaoPaulo)
+	suite.AssertTime(start, nil, 2018, time.November, 4, 1, 0, 0, 0)
+}
+
+func (suite *CoreSuite) TestAlmostMidnight() {
+	succeeds := func(loc *time.Location, year int, month time.Month, day int, expected time.Time) {
+		actual := isodates.AlmostMidnight(year, month, day, loc)
+		_ = suite.True(expected.Equal(actual), "expected %v, got %v", expected, actual) &&
+			suite.Equal(loc, actual.Location())
+	}
+	utc := func(year int, month time.Month, day int, hour int) time.Time {
+		return time.Date(year, month, day, hour, 0, 0, 0, time.UTC).Add(-time.Nanosecond)
+	}
+
+	succeeds(time.UTC, 2019, time.May, 22, utc(2019, time.May, 23, 0))
+	succeeds(time.UTC, 2019, time.May, 0, utc(2019, time.May, 1, 0))
+	succeeds(locationEDT, 2019, time.November, 3, utc(2019, time.November, 4, 5))
+
+	// The next day starts at 1am, so this day ends at 11:59:59pm in the old offset.
+	succeeds(locationSaoPaulo, 2018, time.November, 3, utc(2018, time.November, 4, 3))
+	succeeds(locationHavana, 2019, time.March, 9, utc(2019, time.March, 10, 5))
+	succeeds(locationBeirut, 2019, time.March, 30, utc(2019, time.March, 30, 22))
+
+	// The 11pm hour happens twice, so the day ends after the second one.
+	succeeds(locationSaoPaulo, 2019, time.February, 16, utc(2019, time.February, 17, 3))
+	succeeds(locationBeirut, 2019, time.October, 26, utc(2019, time.October, 26, 22))
+
+	end := isodates.AlmostMidnight(2019, time.February, 16, locationSaoPaulo)
+	suite.AssertTime(end, nil, 2019, time.February, 16, 23, 59, 59, 999999999)
+}
+
+func (suite *CoreSuite) TestDSTRanges() {
+	// Start/End functions should all give you the true boundaries of the local day.
+	start, err := isodates.ParseDateStartIn("2018-11-04", locationSaoPaulo)
+	suite.AssertTime(start, err, 2018, time.November, 4, 1, 0, 0, 0)
+
+	r, err := isodates.ParseDateRangeIn("2018-11-04", locationSaoPaulo)
+	_ = suite.NoError(err) && suite.Equal(23*time.Hour, r.HalfOpen().Duration())
+
+	r, err = isodates.ParseDateRangeIn("2019-02-16", locationSaoPaulo)
+	_ = suite.NoError(err) && suite.Equal(25*time.Hour, r.HalfOpen().Duration())
+
+	end, err := isodates.ParseDateEndExclusiveIn("2018-11-03", locationSaoPaulo)
+	_ = suite.NoError(err) && suite.True(start.Equal(end))
+
+	start, err = isodates.ParseWeekDayStartIn("2019-W13-7", locationBeirut)
+	suite.AssertTime(start, err, 2019, time.March, 31, 1, 0, 0, 0)
+
+	end, err = isodates.ParseYearMonthEndIn("2019-03", locationHavana)
+	suite.AssertTime(end, err, 2019, time.March, 31, 23, 59, 59, 999999999)
+}
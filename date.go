@@ -1,40 +1,70 @@
 package isodates
 
 import (
-	"errors"
+	"fmt"
 	"time"
 )
 
 // ParseDate accepts an ISO-formatted year-month-day string (e.g. "2019-05-22") and returns the
-// year/month/day it represents.
+// year/month/day it represents. The day must actually exist in that month/year, so values such as
+// "2019-02-29" or "2019-04-31" result in an error. Use ParseDateLenient if you want those anyway.
+// It also accepts the basic format (e.g. "20190522") and expanded years with a sign (e.g.
+// "+10000-01-01" or "-0044-03-15"), so it can parse anything that a Formatter writes.
 func ParseDate(input string) (year int, month time.Month, day int, err error) {
+	year, month, day, err = ParseDateLenient(input)
+	if err != nil {
+		return 0, ZeroMonth, 0, err
+	}
+	if daysInMonth := DaysInMonth(year, month); day > daysInMonth {
+		detail := fmt.Sprintf("%s %d has %d days", month, year, daysInMonth)
+		format := dateLayout(input).format
+		return 0, ZeroMonth, 0, nonexistentError(format, input, ComponentDay, len(input)-2, len(input), detail)
+	}
+	return year, month, day, nil
+}
+
+// ParseDateLenient behaves just like ParseDate except that it only requires the day of month to be
+// a positive number. It does not check that the day actually exists in that month, so "2019-02-31" is
+// accepted as-is. If you feed those components to time.Date(), the extra days will roll over into the
+// following month (e.g. March 3rd).
+func ParseDateLenient(input string) (year int, month time.Month, day int, err error) {
 	// We could use the standard time package to parse this, but assuming this format
 	// means that we can cut the execution time in half.
-	if len(input) != 10 {
-		return 0, ZeroMonth, 0, invalidFormat("YYYY-MM-DD", input)
+	layout := dateLayout(input)
+	monthStart := layout.yearEnd + len(layout.separator())
+	dayStart := monthStart + 2 + len(layout.separator())
+
+	if err = layout.checkLength(input); err != nil {
+		return 0, ZeroMonth, 0, err
 	}
-	if input[4] != '-' {
-		return 0, ZeroMonth, 0, invalidFormat("YYYY-MM-DD", input)
+	if err = checkLiteral(layout.format, input, layout.yearEnd, layout.separator()); err != nil {
+		return 0, ZeroMonth, 0, err
 	}
-	if input[7] != '-' {
-		return 0, ZeroMonth, 0, invalidFormat("YYYY-MM-DD", input)
+	if err = checkLiteral(layout.format, input, monthStart+2, layout.separator()); err != nil {
+		return 0, ZeroMonth, 0, err
 	}
 
-	year, err = parseYear(input[0:4])
+	year, err = layout.parseYear(input)
 	if err != nil {
 		return 0, ZeroMonth, 0, err
 	}
-	month, err = parseMonth(input[5:7])
+	month, err = parseMonth(layout.format, input, monthStart, monthStart+2)
 	if err != nil {
 		return 0, ZeroMonth, 0, err
 	}
-	day, err = parseDayOfMonth(input[8:])
+	day, err = parseDayOfMonth(layout.format, input, dayStart, dayStart+2)
 	if err != nil {
 		return 0, ZeroMonth, 0, err
 	}
 	return year, month, day, nil
 }
 
+// dateLayout works out whether the input is an extended ("2019-05-22") or basic ("20190522") date and
+// where its year ends.
+func dateLayout(input string) yearLayout {
+	return layoutOf(input, "YYYY-MM-DD", "YYYYMMDD")
+}
+
 // ParseDateStart accepts an ISO-formatted year-month-day string (e.g. "2019-05-22") and returns the
 // given date set to exactly midnight in UTC.
 func ParseDateStart(input string) (time.Time, error) {
@@ -45,30 +75,61 @@ func ParseDateStart(input string) (time.Time, error) {
 // given date set to exactly midnight in the specified location.
 func ParseDateStartIn(input string, loc *time.Location) (time.Time, error) {
 	if loc == nil {
-		return ZeroTime, errors.New("parse date start: nil location")
+		return ZeroTime, fmt.Errorf("parse date start: %w", ErrNilLocation)
 	}
-	year, month, day, err := ParseDate(input)
+	date, err := ParseDateValue(input)
 	if err != nil {
 		return ZeroTime, err
 	}
-	return Midnight(year, month, day, loc), nil
+	return date.Start(loc), nil
 }
 
 // ParseDateEnd accepts an ISO-formatted year-month-day string (e.g. "2019-05-22") and returns the
-// given date set to the last nanosecond of 11:59pm in UTC.
+// given date set to 11:59:59pm (one EndPrecision before midnight) in UTC.
 func ParseDateEnd(input string) (time.Time, error) {
 	return ParseDateEndIn(input, time.UTC)
 }
 
 // ParseDateEndIn accepts an ISO-formatted year-month-day string (e.g. "2019-05-22") and returns the
-// given date set to the last nanosecond of 11:59pm in the specified location.
+// given date set to 11:59:59pm (one EndPrecision before midnight) in the specified location.
 func ParseDateEndIn(input string, loc *time.Location) (time.Time, error) {
 	if loc == nil {
-		return ZeroTime, errors.New("parse date end: nil location")
+		return ZeroTime, fmt.Errorf("parse date end: %w", ErrNilLocation)
 	}
-	year, month, day, err := ParseDate(input)
+	date, err := ParseDateValue(input)
 	if err != nil {
 		return ZeroTime, err
 	}
-	return AlmostMidnight(year, month, day, loc), nil
+	return date.End(loc), nil
+}
+
+// ParseDateEndExclusive accepts an ISO-formatted year-month-day string (e.g. "2019-05-22") and returns
+// midnight at the start of the following day in UTC. Use it as the exclusive end of the date's range.
+func ParseDateEndExclusive(input string) (time.Time, error) {
+	return ParseDateEndExclusiveIn(input, time.UTC)
+}
+
+// ParseDateEndExclusiveIn accepts an ISO-formatted year-month-day string (e.g. "2019-05-22") and returns
+// midnight at the start of the following day in the specified location.
+func ParseDateEndExclusiveIn(input string, loc *time.Location) (time.Time, error) {
+	return exclusiveEnd(ParseDateEndIn(input, loc))
+}
+
+// ParseDateRange accepts an ISO-formatted year-month-day string (e.g. "2019-05-22") and returns the
+// range from midnight through 11:59:59pm on that date in UTC.
+func ParseDateRange(input string) (Range, error) {
+	return ParseDateRangeIn(input, time.UTC)
+}
+
+// ParseDateRangeIn accepts an ISO-formatted year-month-day string (e.g. "2019-05-22") and returns the
+// range from midnight through 11:59:59pm on that date in the specified location.
+func ParseDateRangeIn(input string, loc *time.Location) (Range, error) {
+	if loc == nil {
+		return Range{}, fmt.Errorf("parse date range: %w", ErrNilLocation)
+	}
+	date, err := ParseDateValue(input)
+	if err != nil {
+		return Range{}, err
+	}
+	return date.Range(loc), nil
 }
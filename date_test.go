@@ -53,6 +53,21 @@ func (suite DateSuite) TestParseDate() {
 	fails("2019-04-XX")
 	fails("2019-04-")
 	fails("2019-04-00")
+	fails("2019-04-31")
+	fails("2019-04-99")
+	fails("2019-02-29")
+	fails("2100-02-29")
+	fails("2019-01-32")
+
+	// Basic format and expanded years
+	fails("2019052")
+	fails("20190229")
+	fails("2019-0522")
+	fails("201905-22")
+	fails("+201-05-22") // expanded years need at least 4 digits
+	fails("+-019-05-22")
+	fails("+2019/05/22")
+	fails("20190532")
 
 	succeeds("0123-01-01", 123, time.January, 1)
 	succeeds("2000-01-01", 2000, time.January, 1)
@@ -60,10 +75,53 @@ func (suite DateSuite) TestParseDate() {
 	succeeds("2004-02-29", 2004, time.February, 29)
 	succeeds("2019-01-01", 2019, time.January, 1)
 	succeeds("2319-12-31", 2319, time.December, 31)
+	succeeds("2019-04-30", 2019, time.April, 30)
+	succeeds("2019-02-28", 2019, time.February, 28)
+	succeeds("20190522", 2019, time.May, 22)
+	succeeds("20000229", 2000, time.February, 29)
+	succeeds("+2019-05-22", 2019, time.May, 22)
+	succeeds("+002019-05-22", 2019, time.May, 22)
+	succeeds("+0020190522", 2019, time.May, 22)
+	succeeds("+10000-01-01", 10000, time.January, 1)
+	succeeds("-0044-03-15", -44, time.March, 15)
+	succeeds("-00440315", -44, time.March, 15)
+}
+
+func (suite DateSuite) TestParseDateLenient() {
+	succeeds := func(input string, year int, month time.Month, day int) {
+		actualYear, actualMonth, actualDay, err := isodates.ParseDateLenient(input)
+		_ = suite.NoError(err) &&
+			suite.Equal(year, actualYear, "incorrect year") &&
+			suite.Equal(month, actualMonth, "incorrect month") &&
+			suite.Equal(day, actualDay, "incorrect day")
+	}
+	fails := func(input string) {
+		_, _, _, err := isodates.ParseDateLenient(input)
+		suite.Error(err)
+	}
+	fails("")
+	fails("not valid")
+	fails("2019-00-11")
+	fails("2019-04-00")
+	fails("2019-04-XX")
+
+	succeeds("2000-02-29", 2000, time.February, 29)
+	succeeds("2019-12-31", 2019, time.December, 31)
 
 	// Don't roll anything over until you feed the values to `time.Date()`
 	succeeds("2005-02-29", 2005, time.February, 29)
 	succeeds("2005-01-33", 2005, time.January, 33)
+	succeeds("2019-04-99", 2019, time.April, 99)
+}
+
+func (suite DateSuite) TestDaysInMonth() {
+	suite.Equal(31, isodates.DaysInMonth(2019, time.January))
+	suite.Equal(28, isodates.DaysInMonth(2019, time.February))
+	suite.Equal(29, isodates.DaysInMonth(2020, time.February))
+	suite.Equal(29, isodates.DaysInMonth(2000, time.February))
+	suite.Equal(28, isodates.DaysInMonth(1900, time.February))
+	suite.Equal(30, isodates.DaysInMonth(2019, time.April))
+	suite.Equal(31, isodates.DaysInMonth(2019, time.December))
 }
 
 func (suite *DateSuite) TestParseDateStart() {
@@ -79,6 +137,7 @@ func (suite *DateSuite) TestParseDateStart() {
 	// TestParseDate runs through all formats, so just make sure failure bubbles up.
 	fails("")
 	fails("not valid")
+	fails("2019-02-31") // Don't silently roll into March
 
 	succeeds("0123-01-01", 123, time.January, 1)
 	succeeds("2000-01-01", 2000, time.January, 1)
@@ -101,6 +160,7 @@ func (suite *DateSuite) TestParseDateStartIn() {
 	fails("", locationEDT)
 	fails("not valid", locationPDT)
 	fails("2000-01-01", nil)
+	fails("2019-04-31", locationEDT)
 
 	succeeds("0123-01-01", 123, time.January, 1, time.UTC)
 	succeeds("0123-01-01", 123, time.January, 1, locationEDT)
@@ -136,6 +196,7 @@ func (suite *DateSuite) TestParseDateEnd() {
 	// TestParseDate runs through all formats, so just make sure failure bubbles up.
 	fails("")
 	fails("not valid")
+	fails("2019-02-31") // Don't silently roll into March
 
 	succeeds("0123-01-01", 123, time.January, 1)
 	succeeds("2000-01-01", 2000, time.January, 1)
@@ -158,6 +219,7 @@ func (suite *DateSuite) TestParseDateEndIn() {
 	fails("", locationEDT)
 	fails("not valid", locationPDT)
 	fails("2000-01-01", nil)
+	fails("2019-04-31", locationEDT)
 
 	succeeds("0123-01-01", 123, time.January, 1, time.UTC)
 	succeeds("0123-01-01", 123, time.January, 1, locationEDT)
@@ -180,6 +242,50 @@ func (suite *DateSuite) TestParseDateEndIn() {
 	succeeds("2319-12-31", 2319, time.December, 31, locationPDT)
 }
 
+func (suite *DateSuite) TestParseDateEndExclusiveIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := isodates.ParseDateEndExclusiveIn(input, loc)
+		suite.AssertMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.ParseDateEndExclusiveIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", time.UTC)
+	fails("2019-02-29", time.UTC)
+	fails("2019-05-22", nil)
+
+	succeeds("2019-05-22", time.UTC, 2019, time.May, 23)
+	succeeds("2019-05-31", locationEDT, 2019, time.June, 1)
+	succeeds("2019-12-31", locationPDT, 2020, time.January, 1)
+	succeeds("2019-03-10", locationEDT, 2019, time.March, 11)
+	succeeds("2019-11-02", locationEDT, 2019, time.November, 3)
+
+	date, err := isodates.ParseDateEndExclusive("2020-02-28")
+	suite.AssertMidnightUTC(date, err, 2020, time.February, 29)
+}
+
+func (suite *DateSuite) TestParseDateRangeIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		r, err := isodates.ParseDateRangeIn(input, loc)
+		suite.AssertRangeIn(r, err, year, month, day, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.ParseDateRangeIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", time.UTC)
+	fails("2019-02-29", time.UTC)
+	fails("2019-05-22", nil)
+
+	succeeds("2019-05-22", time.UTC, 2019, time.May, 22)
+	succeeds("2019-05-22", locationEDT, 2019, time.May, 22)
+	succeeds("2020-02-29", locationPDT, 2020, time.February, 29)
+
+	r, err := isodates.ParseDateRange("2019-05-22")
+	suite.AssertRangeIn(r, err, 2019, time.May, 22, 2019, time.May, 22, time.UTC)
+}
+
 func ExampleParseDate() {
 	year, month, day, err := isodates.ParseDate("2019-02-24")
 	if err != nil {
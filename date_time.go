@@ -7,5 +7,22 @@ import "time"
 func ParseDateTime(input string) (time.Time, error) {
 	// Since there are a bunch of variants for time zone, offset, and millis/nanos, it's
 	// faster to just use the standard library for this.
-	return time.Parse(time.RFC3339, input)
+	date, err := time.Parse(time.RFC3339, input)
+	if err != nil {
+		return ZeroTime, dateTimeError(input, err)
+	}
+	return date, nil
+}
+
+// dateTimeError converts an error from the standard library's parser into a *ParseError. The original
+// error is still available via errors.Unwrap().
+func dateTimeError(input string, cause error) *ParseError {
+	err := formatError("YYYY-MM-DDThh:mm:ssZ", input, 0)
+	err.cause = cause
+
+	// The standard library tells us what was left of the input when it ran into trouble.
+	if timeErr, ok := cause.(*time.ParseError); ok && len(timeErr.ValueElem) <= len(input) {
+		err.Offset = len(input) - len(timeErr.ValueElem)
+	}
+	return err
 }
@@ -0,0 +1,227 @@
+package isodates
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxInt is the largest int, which the standard library only has a constant for as of Go 1.17.
+const maxInt = int(^uint(0) >> 1)
+
+// Duration is an ISO 8601 duration such as "P1Y2M3DT4H5M6S". The date portion is kept separate from
+// the clock portion since months and years don't have a fixed length (e.g. "P1M" is anywhere from 28
+// to 31 days depending on the month you add it to).
+type Duration struct {
+	Years  int
+	Months int
+	Days   int
+	// Clock is the time portion of the duration (the hours, minutes, and seconds after the 'T').
+	Clock time.Duration
+}
+
+// ParseDuration parses an ISO 8601 duration such as "P1M", "P2W", or "P1DT12H". The components have to
+// be in order (years, months, weeks, days, then hours, minutes, and seconds) and each one can only appear
+// once. They must be whole numbers except for seconds, which may have a fraction of up to 9 digits. Weeks
+// are converted to days, so "P2W" is the same as "P14D". A leading '-' (e.g. "-P1DT12H") negates every
+// component; the components themselves can't have a sign.
+func ParseDuration(input string) (Duration, error) {
+	const format = "[-]PnYnMnWnDTnHnMnS"
+	negative, start := strings.HasPrefix(input, "-"), 0
+	if negative {
+		start = 1
+	}
+	if len(input)-start < 3 || input[start] != 'P' {
+		return Duration{}, formatError(format, input, start)
+	}
+
+	var result Duration
+	inTime := false
+	last := -1
+	numberStart := start + 1
+	for i := start + 1; i < len(input); i++ {
+		char := input[i]
+		switch {
+		case isDigit(char), char == '.' && inTime:
+			continue
+		case char == 'T' && !inTime && i == numberStart && i < len(input)-1:
+			inTime = true
+			numberStart = i + 1
+			continue
+		case i == numberStart:
+			return Duration{}, formatError(format, input, i)
+		}
+
+		// Each designator's position in the format; they have to come in that order without repeats.
+		order := strings.IndexByte("YMWD", char)
+		if inTime {
+			if order = strings.IndexByte("HMS", char); order >= 0 {
+				order += 4
+			}
+		}
+		if order <= last {
+			return Duration{}, formatError(format, input, i)
+		}
+		last = order
+
+		if !result.addComponent(char, inTime, input[numberStart:i]) {
+			return Duration{}, formatError(format, input, numberStart)
+		}
+		numberStart = i + 1
+	}
+	if numberStart != len(input) {
+		return Duration{}, formatError(format, input, numberStart)
+	}
+	if negative {
+		result = Duration{Years: -result.Years, Months: -result.Months, Days: -result.Days, Clock: -result.Clock}
+	}
+	return result, nil
+}
+
+// String returns the ISO representation of the duration (e.g. "P1Y2M3DT4H5M6.5S"). Components that
+// are zero are left out, and the zero duration is "PT0S". Negative durations are written with a single
+// leading sign (e.g. "-P1DT12H"). ISO 8601 has no way to write a duration that mixes positive and negative
+// components, so those come out with a sign on each negative component, which ParseDuration won't accept.
+func (d Duration) String() string {
+	if d.IsZero() {
+		return "PT0S"
+	}
+
+	var out strings.Builder
+	if d.negative() {
+		out.WriteByte('-')
+		d = Duration{Years: -d.Years, Months: -d.Months, Days: -d.Days, Clock: -d.Clock}
+	}
+	out.WriteByte('P')
+	writeDurationComponent(&out, int64(d.Years), 'Y')
+	writeDurationComponent(&out, int64(d.Months), 'M')
+	writeDurationComponent(&out, int64(d.Days), 'D')
+	if d.Clock == 0 {
+		return out.String()
+	}
+
+	out.WriteByte('T')
+	writeDurationComponent(&out, int64(d.Clock/time.Hour), 'H')
+	writeDurationComponent(&out, int64(d.Clock%time.Hour/time.Minute), 'M')
+	if seconds := d.Clock % time.Minute; seconds != 0 {
+		out.WriteString(strconv.FormatInt(int64(seconds/time.Second), 10))
+		if fraction := seconds % time.Second; fraction != 0 {
+			out.WriteString(strings.TrimRight(fmt.Sprintf(".%09d", fraction), "0"))
+		}
+		out.WriteByte('S')
+	}
+	return out.String()
+}
+
+// AddTo returns the date/time after adding the duration to it. The years, months, and days are added
+// with time.AddDate(), so they follow the calendar rather than a fixed number of hours.
+func (d Duration) AddTo(date time.Time) time.Time {
+	return d.addTo(date, 1)
+}
+
+// IsZero returns true if this is the zero value (i.e. it was never set/parsed).
+func (d Duration) IsZero() bool {
+	return d == Duration{}
+}
+
+// signs returns whether the duration has any positive components and whether it has any negative ones.
+func (d Duration) signs() (positive bool, negative bool) {
+	positive = d.Years > 0 || d.Months > 0 || d.Days > 0 || d.Clock > 0
+	negative = d.Years < 0 || d.Months < 0 || d.Days < 0 || d.Clock < 0
+	return positive, negative
+}
+
+// negative returns true if the duration has at least one negative component and no positive ones.
+func (d Duration) negative() bool {
+	positive, negative := d.signs()
+	return negative && !positive
+}
+
+// checkSigns makes sure that the duration can be written in a form that ParseDuration reads back. ISO 8601
+// only has a single sign for the whole duration, so it can't have both positive and negative components.
+func (d Duration) checkSigns() error {
+	if positive, negative := d.signs(); positive && negative {
+		return fmt.Errorf("duration mixes positive and negative components: %s", d)
+	}
+	return nil
+}
+
+// addComponent adds the number in front of one of the designators to the duration. It returns false if
+// the number isn't valid for that designator or the total no longer fits.
+func (d *Duration) addComponent(designator byte, inTime bool, number string) bool {
+	if inTime && designator == 'S' {
+		seconds, ok := parseSeconds(number)
+		return ok && d.addClock(seconds)
+	}
+	value, err := strconv.Atoi(number)
+	if err != nil {
+		return false
+	}
+	switch {
+	case inTime && designator == 'H':
+		return int64(value) <= math.MaxInt64/int64(time.Hour) && d.addClock(time.Duration(value)*time.Hour)
+	case inTime && designator == 'M':
+		return int64(value) <= math.MaxInt64/int64(time.Minute) && d.addClock(time.Duration(value)*time.Minute)
+	case designator == 'Y':
+		d.Years = value
+	case designator == 'M':
+		d.Months = value
+	case designator == 'W':
+		if value > maxInt/7 {
+			return false
+		}
+		d.Days = value * 7
+	case designator == 'D':
+		if d.Days > maxInt-value {
+			return false
+		}
+		d.Days += value
+	}
+	return true
+}
+
+// addClock adds to the clock portion of the duration, returning false if the total no longer fits.
+func (d *Duration) addClock(amount time.Duration) bool {
+	if d.Clock > math.MaxInt64-amount {
+		return false
+	}
+	d.Clock += amount
+	return true
+}
+
+// parseSeconds parses the seconds of a duration, which may have a fraction (e.g. "1.5"). We do this
+// ourselves rather than using strconv.ParseFloat() so that we get exact nanoseconds.
+func parseSeconds(number string) (time.Duration, bool) {
+	whole, fraction := number, ""
+	if dot := strings.IndexByte(number, '.'); dot >= 0 {
+		whole, fraction = number[:dot], number[dot+1:]
+		if fraction == "" || len(fraction) > 9 || !isDigits(fraction) {
+			return 0, false
+		}
+	}
+	seconds, err := strconv.Atoi(whole)
+	if err != nil || int64(seconds) > math.MaxInt64/int64(time.Second) {
+		return 0, false
+	}
+	nanos, _ := strconv.Atoi((fraction + "000000000")[:9])
+	total := time.Duration(seconds) * time.Second
+	if total > math.MaxInt64-time.Duration(nanos) {
+		return 0, false
+	}
+	return total + time.Duration(nanos), true
+}
+
+// addTo adds the duration to the date/time (or subtracts it when sign is -1).
+func (d Duration) addTo(date time.Time, sign int) time.Time {
+	return date.AddDate(sign*d.Years, sign*d.Months, sign*d.Days).Add(time.Duration(sign) * d.Clock)
+}
+
+// writeDurationComponent writes one "nX" component of a duration, skipping it when it's zero.
+func writeDurationComponent(out *strings.Builder, value int64, designator byte) {
+	if value != 0 {
+		out.WriteString(strconv.FormatInt(value, 10))
+		out.WriteByte(designator)
+	}
+}
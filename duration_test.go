@@ -0,0 +1,139 @@
+package isodates_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestDurationSuite(t *testing.T) {
+	suite.Run(t, new(DurationSuite))
+}
+
+type DurationSuite struct {
+	ChronoSuite
+}
+
+func (suite *DurationSuite) TestParseDuration() {
+	succeeds := func(input string, expected isodates.Duration) {
+		actual, err := isodates.ParseDuration(input)
+		_ = suite.NoError(err, input) && suite.Equal(expected, actual, input)
+	}
+	fails := func(input string) {
+		_, err := isodates.ParseDuration(input)
+		var parseErr *isodates.ParseError
+		suite.True(errors.As(err, &parseErr), "%s: %v", input, err)
+	}
+
+	fails("")
+	fails("P")
+	fails("1D")
+	fails("PT")
+	fails("P1")
+	fails("P1X")
+	fails("P1H")
+	fails("PT1D")
+	fails("P1DT")
+	fails("PD")
+	fails("P-1D")
+	fails("P1.5D")
+	fails("P1D2Y")
+	fails("P1Y1Y")
+	fails("P1W1Y")
+	fails("PT1M1H")
+	fails("PT1S1M")
+	fails("P1DT1H1H")
+	fails("PT1H-30M")
+	fails("--P1D")
+	fails("-P")
+	fails("+P1D")
+	fails("PT.5S")
+	fails("PT1.S")
+	fails("PT1.1234567891S")
+	fails("PT1.5H")
+	fails("P99999999999999999999D")
+	fails("P1317624576693539402W")
+	fails("P1317624576693539401W1D")
+	fails("PT2562048H")
+	fails("PT153722867281M")
+	fails("PT9223372037S")
+	fails("PT2562047H47M16.854775808S")
+
+	succeeds("P1Y", isodates.Duration{Years: 1})
+	succeeds("P2M", isodates.Duration{Months: 2})
+	succeeds("P2W", isodates.Duration{Days: 14})
+	succeeds("P3D", isodates.Duration{Days: 3})
+	succeeds("PT4H", isodates.Duration{Clock: 4 * time.Hour})
+	succeeds("PT5M", isodates.Duration{Clock: 5 * time.Minute})
+	succeeds("PT1.5S", isodates.Duration{Clock: 1500 * time.Millisecond})
+	succeeds("P1Y2M3DT4H5M6S", isodates.Duration{Years: 1, Months: 2, Days: 3, Clock: 4*time.Hour + 5*time.Minute + 6*time.Second})
+	succeeds("PT0S", isodates.Duration{})
+	succeeds("P1W2D", isodates.Duration{Days: 9})
+	succeeds("PT0.000000001S", isodates.Duration{Clock: time.Nanosecond})
+	succeeds("PT2562047H47M16.854775807S", isodates.Duration{Clock: math.MaxInt64})
+	succeeds("-P1D", isodates.Duration{Days: -1})
+	succeeds("-P1Y2M3DT4H5M6.5S", isodates.Duration{Years: -1, Months: -2, Days: -3, Clock: -(4*time.Hour + 5*time.Minute + 6500*time.Millisecond)})
+}
+
+func (suite *DurationSuite) TestString() {
+	check := func(d isodates.Duration, expected string) {
+		suite.Equal(expected, d.String())
+
+		// Whatever we write, we can read back.
+		actual, err := isodates.ParseDuration(expected)
+		_ = suite.NoError(err, expected) && suite.Equal(d, actual, expected)
+	}
+	check(isodates.Duration{}, "PT0S")
+	check(isodates.Duration{Years: 1}, "P1Y")
+	check(isodates.Duration{Months: 18}, "P18M")
+	check(isodates.Duration{Days: 14}, "P14D")
+	check(isodates.Duration{Days: 1, Clock: 12 * time.Hour}, "P1DT12H")
+	check(isodates.Duration{Clock: 90 * time.Minute}, "PT1H30M")
+	check(isodates.Duration{Clock: 1500 * time.Millisecond}, "PT1.5S")
+	check(isodates.Duration{Clock: 36 * time.Hour}, "PT36H")
+	check(isodates.Duration{Years: 1, Months: 2, Days: 3, Clock: 4*time.Hour + 5*time.Minute + 6*time.Second}, "P1Y2M3DT4H5M6S")
+
+	// Negative durations get a single leading sign.
+	check(isodates.Duration{Clock: -90 * time.Minute}, "-PT1H30M")
+	check(isodates.Duration{Years: -1, Days: -3, Clock: -time.Second}, "-P1Y3DT1S")
+
+	// ISO 8601 can't write mixed signs, so the encoders refuse rather than write something that won't read back.
+	mixed := isodates.Duration{Years: 1, Days: -1}
+	suite.Equal("P1Y-1D", mixed.String())
+	_, err := mixed.MarshalText()
+	suite.Error(err)
+	_, err = mixed.MarshalJSON()
+	suite.Error(err)
+	_, err = mixed.Value()
+	suite.Error(err)
+}
+
+func (suite *DurationSuite) TestAddTo() {
+	start := time.Date(2019, time.January, 31, 0, 0, 0, 0, time.UTC)
+	suite.Equal(time.Date(2019, time.March, 3, 0, 0, 0, 0, time.UTC), isodates.Duration{Months: 1}.AddTo(start))
+	suite.Equal(time.Date(2020, time.February, 1, 12, 0, 0, 0, time.UTC), isodates.Duration{Years: 1, Days: 1, Clock: 12 * time.Hour}.AddTo(start))
+
+	// Days follow the calendar, so they're not always 24 hours long.
+	local := time.Date(2019, time.March, 9, 12, 0, 0, 0, locationEDT)
+	suite.Equal(time.Date(2019, time.March, 10, 12, 0, 0, 0, locationEDT), isodates.Duration{Days: 1}.AddTo(local))
+	suite.Equal(23*time.Hour, isodates.Duration{Days: 1}.AddTo(local).Sub(local))
+}
+
+func ExampleParseDuration() {
+	d, _ := isodates.ParseDuration("P1DT12H")
+	start := time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC)
+
+	fmt.Println(d.Days, d.Clock)
+	fmt.Println(d.AddTo(start))
+	fmt.Println(d)
+
+	// Output:
+	// 1 12h0m0s
+	// 2019-05-02 12:00:00 +0000 UTC
+	// P1DT12H
+}
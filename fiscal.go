@@ -0,0 +1,301 @@
+package isodates
+
+import (
+	"fmt"
+	"time"
+)
+
+// FiscalYearNaming determines which calendar year a fiscal year is named after when the fiscal
+// year spans two calendar years.
+type FiscalYearNaming int
+
+const (
+	// NamedForEndYear names the fiscal year after the calendar year that it ends in. This is the
+	// most common convention (e.g. the US federal FY2020 runs from Oct 1, 2019 to Sep 30, 2020).
+	NamedForEndYear FiscalYearNaming = iota
+	// NamedForStartYear names the fiscal year after the calendar year that it begins in (e.g. a
+	// FY2020 that runs from Apr 1, 2020 to Mar 31, 2021).
+	NamedForStartYear
+)
+
+// USFederalFiscalCalendar is the fiscal calendar used by the US federal government where the fiscal
+// year begins on October 1st and is named for the calendar year in which it ends.
+var USFederalFiscalCalendar = FiscalCalendar{StartMonth: time.October, Naming: NamedForEndYear}
+
+// FiscalCalendar describes a fiscal year that starts on the first day of some month (not necessarily
+// January) and lasts for 12 months. It lets you parse fiscal year strings (e.g. "FY2020") and fiscal
+// quarter strings (e.g. "FY2020-Q1") into the exact date/time ranges they represent.
+type FiscalCalendar struct {
+	// StartMonth is the month on whose first day each fiscal year begins.
+	StartMonth time.Month
+	// Naming determines whether a fiscal year is named for the calendar year it starts or ends in.
+	Naming FiscalYearNaming
+}
+
+// ParseFiscalYear accepts a fiscal year string (e.g. "FY2020") and returns the fiscal year number
+// that it represents.
+func (cal FiscalCalendar) ParseFiscalYear(input string) (int, error) {
+	if err := checkLength("FYYYYY", input, 6); err != nil {
+		return 0, err
+	}
+	if err := checkLiteral("FYYYYY", input, 0, "FY"); err != nil {
+		return 0, err
+	}
+	return parseYear("FYYYYY", input, 2, 6)
+}
+
+// ParseFiscalYearStart returns midnight on the first day of the fiscal year string (e.g. "FY2020"). The
+// resulting date/time will be in UTC.
+func (cal FiscalCalendar) ParseFiscalYearStart(input string) (time.Time, error) {
+	return cal.ParseFiscalYearStartIn(input, time.UTC)
+}
+
+// ParseFiscalYearStartIn returns midnight on the first day of the fiscal year string (e.g. "FY2020"). The
+// resulting date/time will be in the specified time zone.
+func (cal FiscalCalendar) ParseFiscalYearStartIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse fiscal year start: %w", ErrNilLocation)
+	}
+	fiscalYear, err := cal.ParseFiscalYear(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	year, month, err := cal.quarterStart(fiscalYear, 1)
+	if err != nil {
+		return ZeroTime, err
+	}
+	return Midnight(year, month, 1, loc), nil
+}
+
+// ParseFiscalYearEnd returns 11:59:59pm on the last day of the fiscal year string (e.g. "FY2020"). The
+// resulting date/time will be in UTC.
+func (cal FiscalCalendar) ParseFiscalYearEnd(input string) (time.Time, error) {
+	return cal.ParseFiscalYearEndIn(input, time.UTC)
+}
+
+// ParseFiscalYearEndIn returns 11:59:59pm on the last day of the fiscal year string (e.g. "FY2020"). The
+// resulting date/time will be in the specified time zone.
+func (cal FiscalCalendar) ParseFiscalYearEndIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse fiscal year end: %w", ErrNilLocation)
+	}
+	fiscalYear, err := cal.ParseFiscalYear(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	year, month, err := cal.quarterStart(fiscalYear, 1)
+	if err != nil {
+		return ZeroTime, err
+	}
+	return AlmostMidnight(year, month+12, 0, loc), nil
+}
+
+// ParseFiscalYearEndExclusive returns midnight on the first day of the fiscal year after the fiscal
+// year string (e.g. "FY2020"). The resulting date/time will be in UTC.
+func (cal FiscalCalendar) ParseFiscalYearEndExclusive(input string) (time.Time, error) {
+	return cal.ParseFiscalYearEndExclusiveIn(input, time.UTC)
+}
+
+// ParseFiscalYearEndExclusiveIn returns midnight on the first day of the fiscal year after the fiscal
+// year string (e.g. "FY2020"). The resulting date/time will be in the specified time zone.
+func (cal FiscalCalendar) ParseFiscalYearEndExclusiveIn(input string, loc *time.Location) (time.Time, error) {
+	return exclusiveEnd(cal.ParseFiscalYearEndIn(input, loc))
+}
+
+// ParseFiscalYearRange returns the range from midnight on the first day through 11:59:59pm on the last
+// day of the fiscal year string (e.g. "FY2020"). The resulting range will be in UTC.
+func (cal FiscalCalendar) ParseFiscalYearRange(input string) (Range, error) {
+	return cal.ParseFiscalYearRangeIn(input, time.UTC)
+}
+
+// ParseFiscalYearRangeIn returns the range from midnight on the first day through 11:59:59pm on the last
+// day of the fiscal year string (e.g. "FY2020"). The resulting range will be in the specified time zone.
+func (cal FiscalCalendar) ParseFiscalYearRangeIn(input string, loc *time.Location) (Range, error) {
+	if loc == nil {
+		return Range{}, fmt.Errorf("parse fiscal year range: %w", ErrNilLocation)
+	}
+	fiscalYear, err := cal.ParseFiscalYear(input)
+	if err != nil {
+		return Range{}, err
+	}
+	year, month, err := cal.quarterStart(fiscalYear, 1)
+	if err != nil {
+		return Range{}, err
+	}
+	return Range{Start: Midnight(year, month, 1, loc), End: AlmostMidnight(year, month+12, 0, loc)}, nil
+}
+
+// ParseFiscalQuarter accepts a fiscal quarter string (e.g. "FY2020-Q1") and returns the fiscal year
+// and quarter number (1-4) that it represents.
+func (cal FiscalCalendar) ParseFiscalQuarter(input string) (fiscalYear int, quarter int, err error) {
+	if err = checkLength("FYYYYY-Q#", input, 9); err != nil {
+		return 0, 0, err
+	}
+	if err = checkLiteral("FYYYYY-Q#", input, 0, "FY"); err != nil {
+		return 0, 0, err
+	}
+	if err = checkLiteral("FYYYYY-Q#", input, 6, "-Q"); err != nil {
+		return 0, 0, err
+	}
+	fiscalYear, err = parseYear("FYYYYY-Q#", input, 2, 6)
+	if err != nil {
+		return 0, 0, err
+	}
+	quarter, err = parseQuarter("FYYYYY-Q#", input, 8, 9)
+	if err != nil {
+		return 0, 0, err
+	}
+	return fiscalYear, quarter, nil
+}
+
+// ParseFiscalQuarterStart returns midnight on the first day of the fiscal quarter string (e.g. "FY2020-Q1").
+// The resulting date/time will be in UTC.
+func (cal FiscalCalendar) ParseFiscalQuarterStart(input string) (time.Time, error) {
+	return cal.ParseFiscalQuarterStartIn(input, time.UTC)
+}
+
+// ParseFiscalQuarterStartIn returns midnight on the first day of the fiscal quarter string (e.g. "FY2020-Q1").
+// The resulting date/time will be in the specified time zone.
+func (cal FiscalCalendar) ParseFiscalQuarterStartIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse fiscal quarter start: %w", ErrNilLocation)
+	}
+	fiscalYear, quarter, err := cal.ParseFiscalQuarter(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	year, month, err := cal.quarterStart(fiscalYear, quarter)
+	if err != nil {
+		return ZeroTime, err
+	}
+	return Midnight(year, month, 1, loc), nil
+}
+
+// ParseFiscalQuarterEnd returns 11:59:59pm on the last day of the fiscal quarter string (e.g. "FY2020-Q1").
+// The resulting date/time will be in UTC.
+func (cal FiscalCalendar) ParseFiscalQuarterEnd(input string) (time.Time, error) {
+	return cal.ParseFiscalQuarterEndIn(input, time.UTC)
+}
+
+// ParseFiscalQuarterEndIn returns 11:59:59pm on the last day of the fiscal quarter string (e.g. "FY2020-Q1").
+// The resulting date/time will be in the specified time zone.
+func (cal FiscalCalendar) ParseFiscalQuarterEndIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse fiscal quarter end: %w", ErrNilLocation)
+	}
+	fiscalYear, quarter, err := cal.ParseFiscalQuarter(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	year, month, err := cal.quarterStart(fiscalYear, quarter)
+	if err != nil {
+		return ZeroTime, err
+	}
+	// Day 0 of the month after the quarter is the last day of the quarter's final month.
+	return AlmostMidnight(year, month+3, 0, loc), nil
+}
+
+// ParseFiscalQuarterEndExclusive returns midnight on the first day of the quarter after the fiscal
+// quarter string (e.g. "FY2020-Q1"). The resulting date/time will be in UTC.
+func (cal FiscalCalendar) ParseFiscalQuarterEndExclusive(input string) (time.Time, error) {
+	return cal.ParseFiscalQuarterEndExclusiveIn(input, time.UTC)
+}
+
+// ParseFiscalQuarterEndExclusiveIn returns midnight on the first day of the quarter after the fiscal
+// quarter string (e.g. "FY2020-Q1"). The resulting date/time will be in the specified time zone.
+func (cal FiscalCalendar) ParseFiscalQuarterEndExclusiveIn(input string, loc *time.Location) (time.Time, error) {
+	return exclusiveEnd(cal.ParseFiscalQuarterEndIn(input, loc))
+}
+
+// ParseFiscalQuarterRange returns the range from midnight on the first day through 11:59:59pm on the
+// last day of the fiscal quarter string (e.g. "FY2020-Q1"). The resulting range will be in UTC.
+func (cal FiscalCalendar) ParseFiscalQuarterRange(input string) (Range, error) {
+	return cal.ParseFiscalQuarterRangeIn(input, time.UTC)
+}
+
+// ParseFiscalQuarterRangeIn returns the range from midnight on the first day through 11:59:59pm on the
+// last day of the fiscal quarter string (e.g. "FY2020-Q1"). The resulting range will be in the specified
+// time zone.
+func (cal FiscalCalendar) ParseFiscalQuarterRangeIn(input string, loc *time.Location) (Range, error) {
+	if loc == nil {
+		return Range{}, fmt.Errorf("parse fiscal quarter range: %w", ErrNilLocation)
+	}
+	fiscalYear, quarter, err := cal.ParseFiscalQuarter(input)
+	if err != nil {
+		return Range{}, err
+	}
+	year, month, err := cal.quarterStart(fiscalYear, quarter)
+	if err != nil {
+		return Range{}, err
+	}
+	return Range{Start: Midnight(year, month, 1, loc), End: AlmostMidnight(year, month+3, 0, loc)}, nil
+}
+
+// FiscalPeriod returns the fiscal year and quarter (1-4) that the given date/time falls in. The
+// calendar date is taken from the date/time in its own location, so convert it using time.In()
+// first if you want the fiscal period for some other time zone.
+func (cal FiscalCalendar) FiscalPeriod(date time.Time) (fiscalYear int, quarter int, err error) {
+	if cal.StartMonth < time.January || cal.StartMonth > time.December {
+		return 0, 0, fmt.Errorf("invalid fiscal start month: %d", cal.StartMonth)
+	}
+	year, month, _ := date.Date()
+
+	// How many months into the fiscal year are we? Dates before the start month belong to
+	// the fiscal year that started in the previous calendar year.
+	monthsIn := int(month - cal.StartMonth)
+	startYear := year
+	if monthsIn < 0 {
+		monthsIn += 12
+		startYear--
+	}
+
+	fiscalYear = startYear
+	if cal.StartMonth != time.January && cal.Naming == NamedForEndYear {
+		fiscalYear++
+	}
+	return fiscalYear, monthsIn/3 + 1, nil
+}
+
+// FormatFiscalYear returns the fiscal year string (e.g. "FY2020") that the date/time falls in, based on
+// its calendar date in its own location. Fiscal years are always written with 4 digits, so this fails
+// for fiscal years outside of 0000-9999 rather than writing something that ParseFiscalYear can't read.
+func (cal FiscalCalendar) FormatFiscalYear(date time.Time) (string, error) {
+	fiscalYear, _, err := cal.FiscalPeriod(date)
+	if err != nil {
+		return "", err
+	}
+	if fiscalYear < 0 || fiscalYear > 9999 {
+		return "", fmt.Errorf("fiscal year out of range: %d", fiscalYear)
+	}
+	return fmt.Sprintf("FY%04d", fiscalYear), nil
+}
+
+// FormatFiscalQuarter returns the fiscal quarter string (e.g. "FY2020-Q1") that the date/time falls in,
+// based on its calendar date in its own location. Like FormatFiscalYear, the fiscal year has to be from
+// 0000-9999.
+func (cal FiscalCalendar) FormatFiscalQuarter(date time.Time) (string, error) {
+	fiscalYear, err := cal.FormatFiscalYear(date)
+	if err != nil {
+		return "", err
+	}
+	_, quarter, _ := cal.FiscalPeriod(date)
+	return fmt.Sprintf("%s-Q%d", fiscalYear, quarter), nil
+}
+
+// quarterStart determines the calendar year and month that the given fiscal quarter begins in.
+func (cal FiscalCalendar) quarterStart(fiscalYear int, quarter int) (int, time.Month, error) {
+	if cal.StartMonth < time.January || cal.StartMonth > time.December {
+		return 0, ZeroMonth, fmt.Errorf("invalid fiscal start month: %d", cal.StartMonth)
+	}
+
+	// When the fiscal year starts in January it lines up w/ the calendar year, so the naming
+	// convention doesn't matter. Otherwise an "end year" fiscal year began in the prior year.
+	startYear := fiscalYear
+	if cal.StartMonth != time.January && cal.Naming == NamedForEndYear {
+		startYear--
+	}
+
+	// Let time.Date() normalize the month so that quarters can roll over into the next calendar year.
+	d := time.Date(startYear, cal.StartMonth+time.Month(3*(quarter-1)), 1, 0, 0, 0, 0, time.UTC)
+	return d.Year(), d.Month(), nil
+}
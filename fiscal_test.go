@@ -0,0 +1,375 @@
+package isodates_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestFiscalSuite(t *testing.T) {
+	suite.Run(t, new(FiscalSuite))
+}
+
+type FiscalSuite struct {
+	ChronoSuite
+}
+
+var fiscalApril = isodates.FiscalCalendar{StartMonth: time.April, Naming: isodates.NamedForStartYear}
+var fiscalJanuary = isodates.FiscalCalendar{StartMonth: time.January}
+
+func (suite *FiscalSuite) TestParseFiscalYear() {
+	succeeds := func(input string, expectedYear int) {
+		year, err := isodates.USFederalFiscalCalendar.ParseFiscalYear(input)
+		_ = suite.NoError(err) &&
+			suite.Equal(expectedYear, year)
+	}
+	fails := func(input string) {
+		_, err := isodates.USFederalFiscalCalendar.ParseFiscalYear(input)
+		suite.Error(err)
+	}
+	fails("")
+	fails("not valid")
+	fails("2020")
+	fails("FY")
+	fails("FY20")
+	fails("fy2020")
+	fails("FY-2020")
+	fails("FY20201")
+	fails("FYXXXX")
+	fails("FY-123")
+	fails("FY+020")
+	fails("FY 202")
+
+	succeeds("FY2020", 2020)
+	succeeds("FY1999", 1999)
+	succeeds("FY0001", 1)
+}
+
+func (suite *FiscalSuite) TestParseFiscalYearStart() {
+	succeeds := func(cal isodates.FiscalCalendar, input string, year int, month time.Month, day int) {
+		date, err := cal.ParseFiscalYearStart(input)
+		suite.AssertMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(cal isodates.FiscalCalendar, input string) {
+		_, err := cal.ParseFiscalYearStart(input)
+		suite.Error(err)
+	}
+	fails(isodates.USFederalFiscalCalendar, "")
+	fails(isodates.USFederalFiscalCalendar, "FY20")
+	fails(isodates.FiscalCalendar{}, "FY2020")
+	fails(isodates.FiscalCalendar{StartMonth: 13}, "FY2020")
+
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020", 2019, time.October, 1)
+	succeeds(isodates.USFederalFiscalCalendar, "FY2000", 1999, time.October, 1)
+	succeeds(fiscalApril, "FY2020", 2020, time.April, 1)
+	succeeds(fiscalJanuary, "FY2020", 2020, time.January, 1)
+
+	// Naming is irrelevant when the fiscal year matches the calendar year.
+	succeeds(isodates.FiscalCalendar{StartMonth: time.January, Naming: isodates.NamedForStartYear}, "FY2020", 2020, time.January, 1)
+}
+
+func (suite *FiscalSuite) TestParseFiscalYearStartIn() {
+	succeeds := func(cal isodates.FiscalCalendar, input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := cal.ParseFiscalYearStartIn(input, loc)
+		suite.AssertMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(cal isodates.FiscalCalendar, input string, loc *time.Location) {
+		_, err := cal.ParseFiscalYearStartIn(input, loc)
+		suite.Error(err)
+	}
+	fails(isodates.USFederalFiscalCalendar, "", locationEDT)
+	fails(isodates.USFederalFiscalCalendar, "FY2020", nil)
+
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020", locationEDT, 2019, time.October, 1)
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020", locationPDT, 2019, time.October, 1)
+	succeeds(fiscalApril, "FY2020", locationEDT, 2020, time.April, 1)
+	succeeds(fiscalApril, "FY2020", locationPDT, 2020, time.April, 1)
+}
+
+func (suite *FiscalSuite) TestParseFiscalYearEnd() {
+	succeeds := func(cal isodates.FiscalCalendar, input string, year int, month time.Month, day int) {
+		date, err := cal.ParseFiscalYearEnd(input)
+		suite.AssertAlmostMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(cal isodates.FiscalCalendar, input string) {
+		_, err := cal.ParseFiscalYearEnd(input)
+		suite.Error(err)
+	}
+	fails(isodates.USFederalFiscalCalendar, "")
+	fails(isodates.USFederalFiscalCalendar, "FY20")
+	fails(isodates.FiscalCalendar{}, "FY2020")
+
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020", 2020, time.September, 30)
+	succeeds(isodates.USFederalFiscalCalendar, "FY2000", 2000, time.September, 30)
+	succeeds(fiscalApril, "FY2020", 2021, time.March, 31)
+	succeeds(fiscalJanuary, "FY2020", 2020, time.December, 31)
+	succeeds(isodates.FiscalCalendar{StartMonth: time.March}, "FY2020", 2020, time.February, 29)
+	succeeds(isodates.FiscalCalendar{StartMonth: time.March}, "FY2019", 2019, time.February, 28)
+}
+
+func (suite *FiscalSuite) TestParseFiscalYearEndIn() {
+	succeeds := func(cal isodates.FiscalCalendar, input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := cal.ParseFiscalYearEndIn(input, loc)
+		suite.AssertAlmostMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(cal isodates.FiscalCalendar, input string, loc *time.Location) {
+		_, err := cal.ParseFiscalYearEndIn(input, loc)
+		suite.Error(err)
+	}
+	fails(isodates.USFederalFiscalCalendar, "", locationEDT)
+	fails(isodates.USFederalFiscalCalendar, "FY2020", nil)
+
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020", locationEDT, 2020, time.September, 30)
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020", locationPDT, 2020, time.September, 30)
+	succeeds(fiscalApril, "FY2020", locationEDT, 2021, time.March, 31)
+	succeeds(fiscalApril, "FY2020", locationPDT, 2021, time.March, 31)
+}
+
+func (suite *FiscalSuite) TestParseFiscalQuarter() {
+	succeeds := func(input string, expectedYear int, expectedQuarter int) {
+		year, quarter, err := isodates.USFederalFiscalCalendar.ParseFiscalQuarter(input)
+		_ = suite.NoError(err) &&
+			suite.Equal(expectedYear, year) &&
+			suite.Equal(expectedQuarter, quarter)
+	}
+	fails := func(input string) {
+		_, _, err := isodates.USFederalFiscalCalendar.ParseFiscalQuarter(input)
+		suite.Error(err)
+	}
+	fails("")
+	fails("not valid")
+	fails("FY2020")
+	fails("FY2020Q1")
+	fails("FY2020-1")
+	fails("FY2020-Q0")
+	fails("FY2020-Q5")
+	fails("FY2020-QX")
+	fails("FY2020-Q01")
+	fails("FY20-Q1")
+	fails("2020-Q1")
+	fails("FY-123-Q1")
+	fails("FY+020-Q1")
+
+	succeeds("FY2020-Q1", 2020, 1)
+	succeeds("FY2020-Q2", 2020, 2)
+	succeeds("FY2020-Q3", 2020, 3)
+	succeeds("FY2020-Q4", 2020, 4)
+	succeeds("FY0001-Q4", 1, 4)
+}
+
+func (suite *FiscalSuite) TestParseFiscalQuarterStart() {
+	succeeds := func(cal isodates.FiscalCalendar, input string, year int, month time.Month, day int) {
+		date, err := cal.ParseFiscalQuarterStart(input)
+		suite.AssertMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(cal isodates.FiscalCalendar, input string) {
+		_, err := cal.ParseFiscalQuarterStart(input)
+		suite.Error(err)
+	}
+	fails(isodates.USFederalFiscalCalendar, "")
+	fails(isodates.USFederalFiscalCalendar, "FY2020-Q5")
+	fails(isodates.FiscalCalendar{}, "FY2020-Q1")
+
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020-Q1", 2019, time.October, 1)
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020-Q2", 2020, time.January, 1)
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020-Q3", 2020, time.April, 1)
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020-Q4", 2020, time.July, 1)
+
+	succeeds(fiscalApril, "FY2020-Q1", 2020, time.April, 1)
+	succeeds(fiscalApril, "FY2020-Q4", 2021, time.January, 1)
+
+	succeeds(fiscalJanuary, "FY2020-Q1", 2020, time.January, 1)
+	succeeds(fiscalJanuary, "FY2020-Q4", 2020, time.October, 1)
+}
+
+func (suite *FiscalSuite) TestParseFiscalQuarterStartIn() {
+	succeeds := func(cal isodates.FiscalCalendar, input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := cal.ParseFiscalQuarterStartIn(input, loc)
+		suite.AssertMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(cal isodates.FiscalCalendar, input string, loc *time.Location) {
+		_, err := cal.ParseFiscalQuarterStartIn(input, loc)
+		suite.Error(err)
+	}
+	fails(isodates.USFederalFiscalCalendar, "", locationEDT)
+	fails(isodates.USFederalFiscalCalendar, "FY2020-Q1", nil)
+
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020-Q1", locationEDT, 2019, time.October, 1)
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020-Q2", locationPDT, 2020, time.January, 1)
+	succeeds(fiscalApril, "FY2020-Q4", locationEDT, 2021, time.January, 1)
+}
+
+func (suite *FiscalSuite) TestParseFiscalQuarterEnd() {
+	succeeds := func(cal isodates.FiscalCalendar, input string, year int, month time.Month, day int) {
+		date, err := cal.ParseFiscalQuarterEnd(input)
+		suite.AssertAlmostMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(cal isodates.FiscalCalendar, input string) {
+		_, err := cal.ParseFiscalQuarterEnd(input)
+		suite.Error(err)
+	}
+	fails(isodates.USFederalFiscalCalendar, "")
+	fails(isodates.USFederalFiscalCalendar, "FY2020-Q5")
+	fails(isodates.FiscalCalendar{}, "FY2020-Q1")
+
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020-Q1", 2019, time.December, 31)
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020-Q2", 2020, time.March, 31)
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020-Q3", 2020, time.June, 30)
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020-Q4", 2020, time.September, 30)
+
+	succeeds(fiscalApril, "FY2020-Q1", 2020, time.June, 30)
+	succeeds(fiscalApril, "FY2020-Q4", 2021, time.March, 31)
+
+	succeeds(isodates.FiscalCalendar{StartMonth: time.December}, "FY2020-Q1", 2020, time.February, 29)
+}
+
+func (suite *FiscalSuite) TestParseFiscalQuarterEndIn() {
+	succeeds := func(cal isodates.FiscalCalendar, input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := cal.ParseFiscalQuarterEndIn(input, loc)
+		suite.AssertAlmostMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(cal isodates.FiscalCalendar, input string, loc *time.Location) {
+		_, err := cal.ParseFiscalQuarterEndIn(input, loc)
+		suite.Error(err)
+	}
+	fails(isodates.USFederalFiscalCalendar, "", locationEDT)
+	fails(isodates.USFederalFiscalCalendar, "FY2020-Q1", nil)
+
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020-Q1", locationEDT, 2019, time.December, 31)
+	succeeds(isodates.USFederalFiscalCalendar, "FY2020-Q2", locationPDT, 2020, time.March, 31)
+	succeeds(fiscalApril, "FY2020-Q4", locationEDT, 2021, time.March, 31)
+}
+
+func (suite *FiscalSuite) TestParseFiscalEndExclusiveIn() {
+	cal := isodates.USFederalFiscalCalendar
+	_, err := cal.ParseFiscalYearEndExclusiveIn("FY2020", nil)
+	suite.Error(err)
+	_, err = cal.ParseFiscalQuarterEndExclusiveIn("FY2020-Q5", locationEDT)
+	suite.Error(err)
+
+	date, err := cal.ParseFiscalYearEndExclusiveIn("FY2020", locationEDT)
+	suite.AssertMidnightIn(date, err, 2020, time.October, 1, locationEDT)
+
+	date, err = cal.ParseFiscalYearEndExclusive("FY2020")
+	suite.AssertMidnightUTC(date, err, 2020, time.October, 1)
+
+	date, err = cal.ParseFiscalQuarterEndExclusiveIn("FY2020-Q1", locationPDT)
+	suite.AssertMidnightIn(date, err, 2020, time.January, 1, locationPDT)
+
+	date, err = cal.ParseFiscalQuarterEndExclusive("FY2020-Q2")
+	suite.AssertMidnightUTC(date, err, 2020, time.April, 1)
+}
+
+func (suite *FiscalSuite) TestParseFiscalRangeIn() {
+	cal := isodates.USFederalFiscalCalendar
+	_, err := cal.ParseFiscalYearRangeIn("FY2020", nil)
+	suite.Error(err)
+	_, err = cal.ParseFiscalYearRangeIn("2020", locationEDT)
+	suite.Error(err)
+	_, err = cal.ParseFiscalQuarterRangeIn("FY2020-Q1", nil)
+	suite.Error(err)
+	_, err = cal.ParseFiscalQuarterRangeIn("FY2020-Q5", locationEDT)
+	suite.Error(err)
+	_, err = isodates.FiscalCalendar{}.ParseFiscalYearRange("FY2020")
+	suite.Error(err)
+
+	r, err := cal.ParseFiscalYearRangeIn("FY2020", locationEDT)
+	suite.AssertRangeIn(r, err, 2019, time.October, 1, 2020, time.September, 30, locationEDT)
+
+	r, err = cal.ParseFiscalYearRange("FY2020")
+	suite.AssertRangeIn(r, err, 2019, time.October, 1, 2020, time.September, 30, time.UTC)
+
+	r, err = cal.ParseFiscalQuarterRangeIn("FY2020-Q1", locationPDT)
+	suite.AssertRangeIn(r, err, 2019, time.October, 1, 2019, time.December, 31, locationPDT)
+
+	r, err = fiscalApril.ParseFiscalQuarterRange("FY2020-Q4")
+	suite.AssertRangeIn(r, err, 2021, time.January, 1, 2021, time.March, 31, time.UTC)
+}
+
+func (suite *FiscalSuite) TestFiscalPeriod() {
+	succeeds := func(cal isodates.FiscalCalendar, date time.Time, expectedYear int, expectedQuarter int) {
+		year, quarter, err := cal.FiscalPeriod(date)
+		_ = suite.NoError(err) &&
+			suite.Equal(expectedYear, year) &&
+			suite.Equal(expectedQuarter, quarter)
+	}
+	fails := func(cal isodates.FiscalCalendar, date time.Time) {
+		_, _, err := cal.FiscalPeriod(date)
+		suite.Error(err)
+	}
+	fails(isodates.FiscalCalendar{}, time.Now())
+	fails(isodates.FiscalCalendar{StartMonth: 13}, time.Now())
+
+	us := isodates.USFederalFiscalCalendar
+	succeeds(us, time.Date(2019, time.September, 30, 23, 59, 59, 0, time.UTC), 2019, 4)
+	succeeds(us, time.Date(2019, time.October, 1, 0, 0, 0, 0, time.UTC), 2020, 1)
+	succeeds(us, time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC), 2020, 1)
+	succeeds(us, time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), 2020, 2)
+	succeeds(us, time.Date(2020, time.May, 15, 0, 0, 0, 0, time.UTC), 2020, 3)
+	succeeds(us, time.Date(2020, time.August, 15, 0, 0, 0, 0, time.UTC), 2020, 4)
+
+	succeeds(fiscalApril, time.Date(2020, time.March, 31, 0, 0, 0, 0, time.UTC), 2019, 4)
+	succeeds(fiscalApril, time.Date(2020, time.April, 1, 0, 0, 0, 0, time.UTC), 2020, 1)
+	succeeds(fiscalApril, time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC), 2020, 4)
+
+	succeeds(fiscalJanuary, time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), 2020, 1)
+	succeeds(fiscalJanuary, time.Date(2020, time.December, 31, 0, 0, 0, 0, time.UTC), 2020, 4)
+
+	// The calendar date comes from the time's own location.
+	succeeds(us, time.Date(2019, time.September, 30, 22, 0, 0, 0, locationPDT), 2019, 4)
+	succeeds(us, time.Date(2019, time.September, 30, 22, 0, 0, 0, locationPDT).UTC(), 2020, 1)
+}
+
+func (suite *FiscalSuite) TestFormatFiscalQuarter() {
+	succeeds := func(cal isodates.FiscalCalendar, date time.Time, expectedYear string, expectedQuarter string) {
+		year, err := cal.FormatFiscalYear(date)
+		_ = suite.NoError(err) && suite.Equal(expectedYear, year)
+		quarter, err := cal.FormatFiscalQuarter(date)
+		_ = suite.NoError(err) && suite.Equal(expectedQuarter, quarter)
+	}
+	fails := func(cal isodates.FiscalCalendar, date time.Time) {
+		_, err := cal.FormatFiscalYear(date)
+		suite.Error(err)
+		_, err = cal.FormatFiscalQuarter(date)
+		suite.Error(err)
+	}
+	fails(isodates.FiscalCalendar{}, time.Now())
+	fails(isodates.USFederalFiscalCalendar, time.Date(9999, time.October, 1, 0, 0, 0, 0, time.UTC))
+	fails(isodates.USFederalFiscalCalendar, time.Date(-2, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+	us := isodates.USFederalFiscalCalendar
+	succeeds(us, time.Date(2019, time.September, 30, 0, 0, 0, 0, time.UTC), "FY2019", "FY2019-Q4")
+	succeeds(us, time.Date(2019, time.October, 1, 0, 0, 0, 0, time.UTC), "FY2020", "FY2020-Q1")
+	succeeds(fiscalApril, time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC), "FY2020", "FY2020-Q4")
+	succeeds(fiscalJanuary, time.Date(5, time.May, 1, 0, 0, 0, 0, time.UTC), "FY0005", "FY0005-Q2")
+	succeeds(us, time.Date(2019, time.September, 30, 22, 0, 0, 0, locationPDT), "FY2019", "FY2019-Q4")
+}
+
+func (suite *FiscalSuite) TestFormatRoundTrip() {
+	for _, cal := range []isodates.FiscalCalendar{isodates.USFederalFiscalCalendar, fiscalApril, fiscalJanuary} {
+		for date := time.Date(1990, time.January, 1, 12, 0, 0, 0, locationEDT); date.Year() < 2030; date = date.AddDate(0, 0, 11) {
+			text, err := cal.FormatFiscalQuarter(date)
+			suite.Require().NoError(err)
+			r, err := cal.ParseFiscalQuarterRangeIn(text, locationEDT)
+			_ = suite.NoError(err, text) && suite.True(r.Contains(date), "%s: %v", text, date)
+
+			text, err = cal.FormatFiscalYear(date)
+			suite.Require().NoError(err)
+			r, err = cal.ParseFiscalYearRangeIn(text, locationEDT)
+			_ = suite.NoError(err, text) && suite.True(r.Contains(date), "%s: %v", text, date)
+		}
+	}
+}
+
+func ExampleFiscalCalendar_ParseFiscalQuarterStart() {
+	start, err := isodates.USFederalFiscalCalendar.ParseFiscalQuarterStart("FY2020-Q1")
+	if err != nil {
+		fmt.Printf("oops: %v\n", err)
+	}
+	fmt.Println(start.Format("Jan 2, 2006"))
+
+	// Output: Oct 1, 2019
+}
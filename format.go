@@ -0,0 +1,199 @@
+package isodates
+
+import (
+	"fmt"
+	"time"
+)
+
+var (
+	// ExtendedFormat writes the canonical ISO strings with separators (e.g. "2019-05-22" or "2019-W05-3").
+	// This is what the package-level FormatXyz functions use.
+	ExtendedFormat = Formatter{}
+	// BasicFormat writes the ISO strings without separators (e.g. "20190522" or "2019W053").
+	BasicFormat = Formatter{Basic: true}
+)
+
+// Formatter writes dates in one of the ISO 8601 representations. The zero value is the canonical
+// extended format with 4-digit years (see ExtendedFormat). Whatever the options, the output can
+// always be parsed by the matching ParseXyz function (e.g. ParseDate for FormatDate).
+type Formatter struct {
+	// Basic drops the '-' separators (e.g. "20190522" instead of "2019-05-22"). ISO 8601 doesn't have
+	// a basic version of year/month since "201905" looks too much like "YYMMDD", so those keep theirs.
+	Basic bool
+	// ExpandedYearDigits, when set, writes every year with an explicit sign and zero-pads it to that
+	// many digits (e.g. "+002019-05-22" for 6 digits) so you can represent years beyond 9999. Years
+	// always get at least 4 digits. When it's zero, years from 0000-9999 get 4 digits and only years
+	// outside of that range get a sign.
+	ExpandedYearDigits int
+}
+
+// FormatDate returns the ISO year-month-day string (e.g. "2019-05-22") for the calendar date of the
+// date/time in its own location.
+func FormatDate(date time.Time) string {
+	return ExtendedFormat.FormatDate(date)
+}
+
+// FormatWeek returns the ISO year/week string (e.g. "2019-W05") for the calendar date of the date/time
+// in its own location.
+func FormatWeek(date time.Time) string {
+	return ExtendedFormat.FormatWeek(date)
+}
+
+// FormatWeekDay returns the ISO year/week/day string (e.g. "2019-W05-3") for the calendar date of the
+// date/time in its own location.
+func FormatWeekDay(date time.Time) string {
+	return ExtendedFormat.FormatWeekDay(date)
+}
+
+// FormatYearMonth returns the ISO year/month string (e.g. "2019-05") for the calendar date of the
+// date/time in its own location.
+func FormatYearMonth(date time.Time) string {
+	return ExtendedFormat.FormatYearMonth(date)
+}
+
+// FormatMonthDay returns the ISO month/day string (e.g. "--05-22") for the calendar date of the
+// date/time in its own location.
+func FormatMonthDay(date time.Time) string {
+	return ExtendedFormat.FormatMonthDay(date)
+}
+
+// FormatOrdinalDate returns the ISO year/day-of-year string (e.g. "2019-142") for the calendar date
+// of the date/time in its own location.
+func FormatOrdinalDate(date time.Time) string {
+	return ExtendedFormat.FormatOrdinalDate(date)
+}
+
+// FormatInterval returns the ISO 8601 interval for the range (e.g. "2019-05-01/2019-05-31"). Ranges
+// that cover whole days are written as dates; anything else is written with timestamps.
+func FormatInterval(r Range) string {
+	return ExtendedFormat.FormatInterval(r)
+}
+
+// FormatDate returns the ISO year-month-day string (e.g. "2019-05-22") for the calendar date of the
+// date/time in its own location.
+func (f Formatter) FormatDate(date time.Time) string {
+	year, month, day := date.Date()
+	return f.date(year, month, day)
+}
+
+// FormatWeek returns the ISO year/week string (e.g. "2019-W05") for the calendar date of the date/time
+// in its own location. The year is the ISO week-numbering year, so Dec 31, 2019 is "2020-W01".
+func (f Formatter) FormatWeek(date time.Time) string {
+	year, week := date.ISOWeek()
+	return f.week(year, week)
+}
+
+// FormatWeekDay returns the ISO year/week/day string (e.g. "2019-W05-3") for the calendar date of the
+// date/time in its own location. Days are numbered from 1 (Monday) through 7 (Sunday).
+func (f Formatter) FormatWeekDay(date time.Time) string {
+	year, week := date.ISOWeek()
+	return f.weekDay(year, week, isoWeekday(date.Weekday()))
+}
+
+// FormatYearMonth returns the ISO year/month string (e.g. "2019-05") for the calendar date of the
+// date/time in its own location.
+func (f Formatter) FormatYearMonth(date time.Time) string {
+	return f.yearMonth(date.Year(), date.Month())
+}
+
+// FormatMonthDay returns the ISO month/day string (e.g. "--05-22") for the calendar date of the
+// date/time in its own location.
+func (f Formatter) FormatMonthDay(date time.Time) string {
+	_, month, day := date.Date()
+	return f.monthDay(month, day)
+}
+
+// FormatOrdinalDate returns the ISO year/day-of-year string (e.g. "2019-142") for the calendar date
+// of the date/time in its own location.
+func (f Formatter) FormatOrdinalDate(date time.Time) string {
+	return f.ordinalDate(date.Year(), date.YearDay())
+}
+
+// FormatInterval returns the ISO 8601 interval for the range (e.g. "2019-05-01/2019-05-31"). When both
+// sides fall on day boundaries in the same location (e.g. the ParseXyzRange functions), the interval is
+// written as the first and last dates. Anything else is written with RFC 3339 timestamps just like
+// Range.String(). Unbounded sides are written as "..". The interval's end is inclusive, so you get back
+// the inclusive version of the range from ParseIntervalIn() with the range's location.
+func (f Formatter) FormatInterval(r Range) string {
+	if r.isZero() || !r.wholeDays() {
+		return r.String()
+	}
+	start, end := "..", ".."
+	if !r.LowerUnbounded {
+		start = f.FormatDate(r.Start)
+	}
+	if !r.UpperUnbounded {
+		end = f.FormatDate(instantBefore(r.HalfOpen().End))
+	}
+	return start + "/" + end
+}
+
+func (f Formatter) date(year int, month time.Month, day int) string {
+	return fmt.Sprintf("%s%s%02d%s%02d", f.year(year), f.separator(), int(month), f.separator(), day)
+}
+
+func (f Formatter) week(year int, week int) string {
+	return fmt.Sprintf("%s%sW%02d", f.year(year), f.separator(), week)
+}
+
+func (f Formatter) weekDay(year int, week int, day int) string {
+	return fmt.Sprintf("%s%s%d", f.week(year, week), f.separator(), day)
+}
+
+func (f Formatter) yearMonth(year int, month time.Month) string {
+	return fmt.Sprintf("%s-%02d", f.year(year), int(month))
+}
+
+func (f Formatter) monthDay(month time.Month, day int) string {
+	return fmt.Sprintf("--%02d%s%02d", int(month), f.separator(), day)
+}
+
+func (f Formatter) ordinalDate(year int, dayOfYear int) string {
+	return fmt.Sprintf("%s%s%03d", f.year(year), f.separator(), dayOfYear)
+}
+
+func (f Formatter) separator() string {
+	if f.Basic {
+		return ""
+	}
+	return "-"
+}
+
+func (f Formatter) year(year int) string {
+	switch {
+	case f.ExpandedYearDigits > 4:
+		return fmt.Sprintf("%+0*d", f.ExpandedYearDigits+1, year)
+	case f.ExpandedYearDigits > 0:
+		return fmt.Sprintf("%+05d", year)
+	case year < 0:
+		return fmt.Sprintf("-%04d", -year)
+	case year > 9999:
+		return fmt.Sprintf("+%d", year)
+	default:
+		return fmt.Sprintf("%04d", year)
+	}
+}
+
+// wholeDays returns true if each side of the range falls on a day boundary in the same location, so it
+// can be written as dates without losing anything.
+func (r Range) wholeDays() bool {
+	switch {
+	case !r.LowerUnbounded && !isMidnight(r.Start):
+		return false
+	case !r.UpperUnbounded && !isMidnight(r.HalfOpen().End):
+		return false
+	case !r.LowerUnbounded && !r.UpperUnbounded:
+		return r.Start.Location() == r.End.Location()
+	default:
+		return true
+	}
+}
+
+// isoWeekday converts the standard library's Sunday-based weekday to the ISO day number where
+// Monday is 1 and Sunday is 7.
+func isoWeekday(weekday time.Weekday) int {
+	if weekday == time.Sunday {
+		return 7
+	}
+	return int(weekday)
+}
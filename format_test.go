@@ -0,0 +1,194 @@
+package isodates_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestFormatSuite(t *testing.T) {
+	suite.Run(t, new(FormatSuite))
+}
+
+type FormatSuite struct {
+	ChronoSuite
+}
+
+func (suite *FormatSuite) TestExtendedFormat() {
+	date := time.Date(2019, time.May, 22, 12, 30, 0, 0, time.UTC)
+	suite.Equal("2019-05-22", isodates.FormatDate(date))
+	suite.Equal("2019-W21", isodates.FormatWeek(date))
+	suite.Equal("2019-W21-3", isodates.FormatWeekDay(date))
+	suite.Equal("2019-05", isodates.FormatYearMonth(date))
+	suite.Equal("--05-22", isodates.FormatMonthDay(date))
+	suite.Equal("2019-142", isodates.FormatOrdinalDate(date))
+
+	// The week-numbering year isn't always the calendar year.
+	suite.Equal("2020-W01-2", isodates.FormatWeekDay(time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC)))
+	suite.Equal("2020-W53-7", isodates.FormatWeekDay(time.Date(2021, time.January, 3, 0, 0, 0, 0, time.UTC)))
+
+	// The calendar date comes from the date/time's own location.
+	late := time.Date(2019, time.May, 22, 23, 0, 0, 0, locationEDT)
+	suite.Equal("2019-05-22", isodates.FormatDate(late))
+	suite.Equal("2019-05-23", isodates.FormatDate(late.UTC()))
+
+	suite.Equal("0001-01-01", isodates.FormatDate(time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	suite.Equal("2020-366", isodates.FormatOrdinalDate(time.Date(2020, time.December, 31, 0, 0, 0, 0, time.UTC)))
+	suite.Equal("2019-001", isodates.FormatOrdinalDate(time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)))
+	suite.Equal("-0044-03-15", isodates.FormatDate(time.Date(-44, time.March, 15, 0, 0, 0, 0, time.UTC)))
+	suite.Equal("+10000-01-01", isodates.FormatDate(time.Date(10000, time.January, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func (suite *FormatSuite) TestBasicFormat() {
+	date := time.Date(2019, time.May, 22, 12, 30, 0, 0, time.UTC)
+	suite.Equal("20190522", isodates.BasicFormat.FormatDate(date))
+	suite.Equal("2019W21", isodates.BasicFormat.FormatWeek(date))
+	suite.Equal("2019W213", isodates.BasicFormat.FormatWeekDay(date))
+	suite.Equal("2019-05", isodates.BasicFormat.FormatYearMonth(date))
+	suite.Equal("--0522", isodates.BasicFormat.FormatMonthDay(date))
+	suite.Equal("2019142", isodates.BasicFormat.FormatOrdinalDate(date))
+}
+
+func (suite *FormatSuite) TestExpandedYears() {
+	date := time.Date(2019, time.May, 22, 12, 30, 0, 0, time.UTC)
+	expanded := isodates.Formatter{ExpandedYearDigits: 6}
+	suite.Equal("+002019-05-22", expanded.FormatDate(date))
+	suite.Equal("+002019-W21", expanded.FormatWeek(date))
+	suite.Equal("+002019-W21-3", expanded.FormatWeekDay(date))
+	suite.Equal("+002019-05", expanded.FormatYearMonth(date))
+	suite.Equal("--05-22", expanded.FormatMonthDay(date))
+	suite.Equal("+002019-142", expanded.FormatOrdinalDate(date))
+
+	suite.Equal("-000044-03-15", expanded.FormatDate(time.Date(-44, time.March, 15, 0, 0, 0, 0, time.UTC)))
+	suite.Equal("+012345-01-01", expanded.FormatDate(time.Date(12345, time.January, 1, 0, 0, 0, 0, time.UTC)))
+
+	basicExpanded := isodates.Formatter{Basic: true, ExpandedYearDigits: 5}
+	suite.Equal("+020190522", basicExpanded.FormatDate(date))
+
+	// Years always get at least 4 digits.
+	short := isodates.Formatter{ExpandedYearDigits: 2}
+	suite.Equal("+2019-05-22", short.FormatDate(date))
+	suite.Equal("-0044-03-15", short.FormatDate(time.Date(-44, time.March, 15, 0, 0, 0, 0, time.UTC)))
+}
+
+func (suite *FormatSuite) TestRoundTrip() {
+	check := func(f isodates.Formatter, date time.Time) bool {
+		year, month, day, err := isodates.ParseDate(f.FormatDate(date))
+		if !suite.NoError(err) || !suite.Equal(isodates.Date{Year: date.Year(), Month: date.Month(), Day: date.Day()}, isodates.Date{Year: year, Month: month, Day: day}) {
+			return false
+		}
+
+		weekYear, week := date.ISOWeek()
+		actualYear, actualWeek, err := isodates.ParseWeek(f.FormatWeek(date))
+		if !suite.NoError(err) || !suite.Equal([]int{weekYear, week}, []int{actualYear, actualWeek}) {
+			return false
+		}
+		start, err := isodates.ParseWeekDayStart(f.FormatWeekDay(date))
+		if !suite.NoError(err) || !suite.True(date.Equal(start), "%v: %v", date, start) {
+			return false
+		}
+
+		actualYear, actualMonth, err := isodates.ParseYearMonth(f.FormatYearMonth(date))
+		if !suite.NoError(err) || !suite.Equal(date.Year(), actualYear) || !suite.Equal(date.Month(), actualMonth) {
+			return false
+		}
+
+		actualMonth, actualDay, err := isodates.ParseMonthDay(f.FormatMonthDay(date))
+		if !suite.NoError(err) || !suite.Equal(date.Month(), actualMonth) || !suite.Equal(date.Day(), actualDay) {
+			return false
+		}
+
+		actualYear, dayOfYear, err := isodates.ParseOrdinalDate(f.FormatOrdinalDate(date))
+		if !suite.NoError(err) || !suite.Equal(date.Year(), actualYear) || !suite.Equal(date.YearDay(), dayOfYear) {
+			return false
+		}
+
+		r := isodates.Range{Start: date, End: isodates.AlmostMidnight(date.Year(), date.Month(), date.Day()+9, time.UTC)}
+		actualRange, err := isodates.ParseIntervalIn(f.FormatInterval(r), time.UTC)
+		return suite.NoError(err) && suite.True(r.Equal(actualRange), "%v: %v", r, actualRange)
+	}
+
+	formatters := []isodates.Formatter{
+		isodates.ExtendedFormat,
+		isodates.BasicFormat,
+		{ExpandedYearDigits: 2},
+		{ExpandedYearDigits: 6},
+		{Basic: true, ExpandedYearDigits: 5},
+	}
+	for _, f := range formatters {
+		// Every day around the turn of a few years (including ones with 53 ISO weeks and ones that
+		// need a sign)...
+		for _, year := range []int{-12345, -44, -1, 0, 1, 1999, 2000, 2004, 2015, 2019, 2020, 2100, 9998, 9999, 10000, 123456} {
+			date := time.Date(year, time.December, 20, 0, 0, 0, 0, time.UTC)
+			for i := 0; i < 20; i++ {
+				if !check(f, date.AddDate(0, 0, i)) {
+					return
+				}
+			}
+		}
+		// ...and a sampling of days across a whole lot of years.
+		for date := time.Date(-20000, time.January, 1, 0, 0, 0, 0, time.UTC); date.Year() <= 20000; date = date.AddDate(0, 0, 997) {
+			if !check(f, date) {
+				return
+			}
+		}
+	}
+}
+
+func (suite *FormatSuite) TestFormatInterval() {
+	check := func(f isodates.Formatter, r isodates.Range, loc *time.Location, expected string) {
+		text := f.FormatInterval(r)
+		suite.Equal(expected, text)
+
+		actual, err := isodates.ParseIntervalIn(text, loc)
+		_ = suite.NoError(err, text) && suite.True(r.Equal(actual), "%s: %v", text, actual)
+	}
+
+	// Whole days are written as dates, which read back in the range's location.
+	check(isodates.ExtendedFormat, day(2019, time.May, 22), time.UTC, "2019-05-22/2019-05-22")
+	check(isodates.BasicFormat, days(2019, time.May, 1, 2019, time.May, 31), time.UTC, "20190501/20190531")
+	check(isodates.Formatter{ExpandedYearDigits: 6}, day(2019, time.May, 22), time.UTC, "+002019-05-22/+002019-05-22")
+	week, _ := isodates.ParseWeekRangeIn("2019-W05", locationEDT)
+	check(isodates.ExtendedFormat, week, locationEDT, "2019-01-28/2019-02-03")
+	fallBack, _ := isodates.ParseDateRangeIn("2019-11-03", locationEDT)
+	check(isodates.ExtendedFormat, fallBack, locationEDT, "2019-11-03/2019-11-03")
+
+	// Unbounded sides are "..".
+	check(isodates.ExtendedFormat, isodates.Range{Start: day(2019, time.May, 22).Start, UpperUnbounded: true}, time.UTC, "2019-05-22/..")
+	check(isodates.ExtendedFormat, isodates.Range{LowerUnbounded: true, UpperUnbounded: true}, time.UTC, "../..")
+
+	// Anything that isn't whole days needs timestamps.
+	check(isodates.ExtendedFormat, isodates.Range{
+		Start: time.Date(2019, time.May, 22, 10, 0, 0, 0, time.UTC),
+		End:   time.Date(2019, time.May, 22, 11, 30, 0, 0, time.UTC),
+	}, time.UTC, "2019-05-22T10:00:00Z/2019-05-22T11:30:00Z")
+	check(isodates.BasicFormat, isodates.Range{
+		Start: isodates.Midnight(2019, time.May, 22, locationEDT),
+		End:   isodates.AlmostMidnight(2019, time.May, 22, locationPDT),
+	}, time.UTC, "2019-05-22T00:00:00-04:00/2019-05-22T23:59:59.999999999-07:00")
+
+	// The interval's end is inclusive, so half-open ranges come back inclusive.
+	halfOpen := day(2019, time.May, 22).HalfOpen()
+	suite.Equal("2019-05-22/2019-05-22", isodates.FormatInterval(halfOpen))
+	actual, err := isodates.ParseInterval(isodates.FormatInterval(halfOpen))
+	_ = suite.NoError(err) && suite.True(halfOpen.Equal(actual.HalfOpen()))
+
+	suite.Equal("", isodates.FormatInterval(isodates.Range{}))
+}
+
+func ExampleFormatWeek() {
+	date := time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC)
+	fmt.Println(isodates.FormatDate(date))
+	fmt.Println(isodates.FormatWeek(date))
+	fmt.Println(isodates.FormatOrdinalDate(date))
+	fmt.Println(isodates.BasicFormat.FormatWeekDay(date))
+
+	// Output:
+	// 2019-12-31
+	// 2020-W01
+	// 2019-365
+	// 2020W012
+}
@@ -0,0 +1,128 @@
+package isodates
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// intervalFormat is the format we report when an interval doesn't parse.
+const intervalFormat = "start/end, start/duration, or duration/end"
+
+// ParseInterval accepts an ISO 8601 interval and returns the range it represents. Each side of the '/'
+// can be anything that Parse() accepts except a month/day, or a duration such as "P1M". The interval runs
+// from the start of the first value through the end of the second, so "2019-05-01/2019-W22" ends on
+// Sunday, June 2nd. A duration is added to (or subtracted from) the other side, so "2019-05-01/P1M" is all
+// of May. Either side can also be ".." to leave the range unbounded on that side (e.g. "2019-05-01/..").
+// You can also pass a single value without a '/', such as "2019-W05". Dates and weeks are in UTC while
+// timestamps keep their own offsets. The resulting range is always inclusive, since ISO 8601 intervals
+// have no way to mark an exclusive end; use HalfOpen() on the result if you need one.
+func ParseInterval(input string) (Range, error) {
+	return ParseIntervalIn(input, time.UTC)
+}
+
+// ParseIntervalIn accepts an ISO 8601 interval (see ParseInterval) and returns the range it represents.
+// Dates and weeks are in the specified time zone while timestamps keep their own offsets. The resulting
+// range is always inclusive.
+func ParseIntervalIn(input string, loc *time.Location) (Range, error) {
+	if loc == nil {
+		return Range{}, fmt.Errorf("parse interval: %w", ErrNilLocation)
+	}
+
+	slash := strings.IndexByte(input, '/')
+	if slash < 0 {
+		value, err := Parse(input)
+		if err != nil {
+			return Range{}, err
+		}
+		return value.Range(boundLocation(value, loc))
+	}
+
+	first, second := input[:slash], input[slash+1:]
+	switch {
+	case first == ".." || second == "..":
+		return parseOpenInterval(first, second, loc)
+
+	case strings.HasPrefix(first, "P") && strings.HasPrefix(second, "P"):
+		return Range{}, formatError(intervalFormat, input, slash+1)
+
+	case strings.HasPrefix(second, "P"):
+		start, err := parseIntervalStart(first, loc)
+		if err != nil {
+			return Range{}, err
+		}
+		period, err := ParseDuration(second)
+		if err != nil {
+			return Range{}, err
+		}
+		return Range{Start: start, End: period.addTo(start, 1).Add(-endPrecision())}, nil
+
+	case strings.HasPrefix(first, "P"):
+		end, err := parseIntervalEnd(second, loc)
+		if err != nil {
+			return Range{}, err
+		}
+		period, err := ParseDuration(first)
+		if err != nil {
+			return Range{}, err
+		}
+		return Range{Start: period.addTo(instantAfter(end), -1), End: end}, nil
+
+	default:
+		start, err := parseIntervalStart(first, loc)
+		if err != nil {
+			return Range{}, err
+		}
+		end, err := parseIntervalEnd(second, loc)
+		if err != nil {
+			return Range{}, err
+		}
+		if end.Before(start) {
+			return Range{}, formatError(intervalFormat, input, slash+1)
+		}
+		return Range{Start: start, End: end}, nil
+	}
+}
+
+// parseOpenInterval parses an interval where at least one side is ".." (i.e. unbounded).
+func parseOpenInterval(first string, second string, loc *time.Location) (Range, error) {
+	r := Range{LowerUnbounded: first == "..", UpperUnbounded: second == ".."}
+	var err error
+	if !r.LowerUnbounded {
+		if r.Start, err = parseIntervalStart(first, loc); err != nil {
+			return Range{}, err
+		}
+	}
+	if !r.UpperUnbounded {
+		if r.End, err = parseIntervalEnd(second, loc); err != nil {
+			return Range{}, err
+		}
+	}
+	return r, nil
+}
+
+func parseIntervalStart(input string, loc *time.Location) (time.Time, error) {
+	value, err := Parse(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	return value.Start(boundLocation(value, loc))
+}
+
+func parseIntervalEnd(input string, loc *time.Location) (time.Time, error) {
+	value, err := Parse(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	return value.End(boundLocation(value, loc))
+}
+
+// boundLocation returns the location to use for one side of an interval. Dates and weeks are in the
+// location you asked for, but timestamps keep the offset that they were written with, so a range that
+// goes through Range.String() comes back with the same offsets.
+func boundLocation(value Value, loc *time.Location) *time.Location {
+	if value.Kind == KindDateTime {
+		return value.DateTime.Location()
+	}
+	return loc
+}
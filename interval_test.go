@@ -0,0 +1,57 @@
+package isodates_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestIntervalSuite(t *testing.T) {
+	suite.Run(t, new(IntervalSuite))
+}
+
+type IntervalSuite struct {
+	ChronoSuite
+}
+
+func (suite *IntervalSuite) TestParseIntervalIn() {
+	succeeds := func(input string, loc *time.Location, startYear int, startMonth time.Month, startDay int, endYear int, endMonth time.Month, endDay int) {
+		r, err := isodates.ParseIntervalIn(input, loc)
+		_ = suite.AssertMidnightIn(r.Start, err, startYear, startMonth, startDay, loc) &&
+			suite.AssertAlmostMidnightIn(r.End, err, endYear, endMonth, endDay, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.ParseIntervalIn(input, loc)
+		suite.Error(err, input)
+	}
+	fails("", time.UTC)
+	fails("2019-05-01/2019-W53", time.UTC)
+	fails("P1D/P1D", time.UTC)
+	fails("2019-05-01/P1M", nil)
+
+	succeeds("2019-05-01/2019-05-31", time.UTC, 2019, time.May, 1, 2019, time.May, 31)
+	succeeds("2019-05-01/2019-05-31", locationEDT, 2019, time.May, 1, 2019, time.May, 31)
+	succeeds("20190501/20190531", locationPDT, 2019, time.May, 1, 2019, time.May, 31)
+	succeeds("2019-W05", locationEDT, 2019, time.January, 28, 2019, time.February, 3)
+	succeeds("2019-03-01/P1M", locationEDT, 2019, time.March, 1, 2019, time.March, 31)
+	succeeds("P1W/2019-11-03", locationEDT, 2019, time.October, 28, 2019, time.November, 3)
+
+	_, err := isodates.ParseIntervalIn("2019-05-01/P1M", nil)
+	suite.True(errors.Is(err, isodates.ErrNilLocation))
+}
+
+func ExampleParseIntervalIn() {
+	ny, _ := time.LoadLocation("America/New_York")
+	r, _ := isodates.ParseIntervalIn("2019-05-01/P1M", ny)
+	fmt.Println(r.Start.Format(time.RFC3339))
+	fmt.Println(r.End.Format(time.RFC3339))
+	fmt.Println(isodates.FormatInterval(r))
+
+	// Output: 2019-05-01T00:00:00-04:00
+	// 2019-05-31T23:59:59-04:00
+	// 2019-05-01/2019-05-31
+}
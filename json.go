@@ -0,0 +1,254 @@
+package isodates
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+)
+
+/*
+ * The value types are encoded as their ISO strings (e.g. "2019-W05"), so you can use them directly
+ * as fields in API request/response structs. Zero values are encoded as null, and null leaves the
+ * value untouched (just like the standard library does for time.Time). Strings that don't parse
+ * result in the usual *ParseError, and values that aren't strings at all fail with a
+ * *json.UnmarshalTypeError just like any other type mismatch.
+ */
+
+// MarshalJSON encodes the date as an ISO string such as "2019-05-22".
+func (date Date) MarshalJSON() ([]byte, error) {
+	return marshalJSONString(date, date.IsZero())
+}
+
+// UnmarshalJSON decodes an ISO string such as "2019-05-22", following the same rules as ParseDate.
+func (date *Date) UnmarshalJSON(data []byte) error {
+	input, ok, err := unmarshalJSONString(data, date)
+	if !ok {
+		return err
+	}
+	value, err := ParseDateValue(input)
+	if err != nil {
+		return err
+	}
+	*date = value
+	return nil
+}
+
+// MarshalJSON encodes the week as an ISO string such as "2019-W05".
+func (week Week) MarshalJSON() ([]byte, error) {
+	return marshalJSONString(week, week.IsZero())
+}
+
+// UnmarshalJSON decodes an ISO string such as "2019-W05", following the same rules as ParseWeek.
+func (week *Week) UnmarshalJSON(data []byte) error {
+	input, ok, err := unmarshalJSONString(data, week)
+	if !ok {
+		return err
+	}
+	value, err := ParseWeekValue(input)
+	if err != nil {
+		return err
+	}
+	*week = value
+	return nil
+}
+
+// MarshalJSON encodes the week/day as an ISO string such as "2019-W05-3".
+func (weekDay WeekDay) MarshalJSON() ([]byte, error) {
+	return marshalJSONString(weekDay, weekDay.IsZero())
+}
+
+// UnmarshalJSON decodes an ISO string such as "2019-W05-3", following the same rules as ParseWeekDay.
+func (weekDay *WeekDay) UnmarshalJSON(data []byte) error {
+	input, ok, err := unmarshalJSONString(data, weekDay)
+	if !ok {
+		return err
+	}
+	value, err := ParseWeekDayValue(input)
+	if err != nil {
+		return err
+	}
+	*weekDay = value
+	return nil
+}
+
+// MarshalJSON encodes the year/month as an ISO string such as "2019-05".
+func (yearMonth YearMonth) MarshalJSON() ([]byte, error) {
+	return marshalJSONString(yearMonth, yearMonth.IsZero())
+}
+
+// UnmarshalJSON decodes an ISO string such as "2019-05", following the same rules as ParseYearMonth.
+func (yearMonth *YearMonth) UnmarshalJSON(data []byte) error {
+	input, ok, err := unmarshalJSONString(data, yearMonth)
+	if !ok {
+		return err
+	}
+	value, err := ParseYearMonthValue(input)
+	if err != nil {
+		return err
+	}
+	*yearMonth = value
+	return nil
+}
+
+// MarshalJSON encodes the month/day as an ISO string such as "--05-22".
+func (monthDay MonthDay) MarshalJSON() ([]byte, error) {
+	return marshalJSONString(monthDay, monthDay.IsZero())
+}
+
+// UnmarshalJSON decodes an ISO string such as "--05-22", following the same rules as ParseMonthDay.
+func (monthDay *MonthDay) UnmarshalJSON(data []byte) error {
+	input, ok, err := unmarshalJSONString(data, monthDay)
+	if !ok {
+		return err
+	}
+	value, err := ParseMonthDayValue(input)
+	if err != nil {
+		return err
+	}
+	*monthDay = value
+	return nil
+}
+
+// MarshalJSON encodes the duration as an ISO string such as "P1DT12H". Durations that mix positive and
+// negative components can't be written as ISO strings, so they result in an error.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	if err := d.checkSigns(); err != nil {
+		return nil, err
+	}
+	return marshalJSONString(d, d.IsZero())
+}
+
+// UnmarshalJSON decodes an ISO string such as "P1DT12H", following the same rules as ParseDuration.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	input, ok, err := unmarshalJSONString(data, d)
+	if !ok {
+		return err
+	}
+	value, err := ParseDuration(input)
+	if err != nil {
+		return err
+	}
+	*d = value
+	return nil
+}
+
+// jsonRange is the JSON representation of a Range. The times are kept as raw JSON so that we can parse
+// them with ParseDateTime() and report bad ones as a *ParseError, and so that we can tell a null
+// (unbounded) time apart from a missing one.
+type jsonRange struct {
+	Start     json.RawMessage `json:"start"`
+	End       json.RawMessage `json:"end"`
+	Exclusive bool            `json:"exclusive,omitempty"`
+}
+
+// MarshalJSON encodes the range as an object such as {"start":"2019-05-22T00:00:00Z","end":"2019-05-22T23:59:59.999999999Z"}.
+// The "exclusive" field is only included for half-open ranges, and an unbounded side is null. The zero
+// Range is encoded as null.
+func (r Range) MarshalJSON() ([]byte, error) {
+	if r.isZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(jsonRange{
+		Start:     marshalJSONBound(r.Start, r.LowerUnbounded),
+		End:       marshalJSONBound(r.End, r.UpperUnbounded),
+		Exclusive: r.Exclusive && !r.UpperUnbounded,
+	})
+}
+
+// UnmarshalJSON decodes an object with RFC 3339 "start" and "end" fields and an optional "exclusive" flag.
+// Both times are required, but either one can be null if the range is unbounded on that side.
+func (r *Range) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) == 0 || data[0] != '{' {
+		return &json.UnmarshalTypeError{Value: jsonKind(data), Type: reflect.TypeOf(r).Elem()}
+	}
+
+	var value jsonRange
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	start, lowerUnbounded, err := unmarshalJSONBound(value.Start)
+	if err != nil {
+		return err
+	}
+	end, upperUnbounded, err := unmarshalJSONBound(value.End)
+	if err != nil {
+		return err
+	}
+	*r = Range{
+		Start:          start,
+		End:            end,
+		Exclusive:      value.Exclusive,
+		LowerUnbounded: lowerUnbounded,
+		UpperUnbounded: upperUnbounded,
+	}
+	return nil
+}
+
+// marshalJSONBound encodes one side of a range as an RFC 3339 string, or null if it's unbounded.
+func marshalJSONBound(t time.Time, unbounded bool) json.RawMessage {
+	if unbounded {
+		return json.RawMessage("null")
+	}
+	return json.RawMessage(`"` + t.Format(time.RFC3339Nano) + `"`)
+}
+
+// unmarshalJSONBound decodes one side of a range. The boolean is true when it's null (i.e. unbounded).
+func unmarshalJSONBound(data json.RawMessage) (time.Time, bool, error) {
+	if string(data) == "null" {
+		return ZeroTime, true, nil
+	}
+	var input string
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &input); err != nil {
+			return ZeroTime, false, err
+		}
+	}
+	t, err := ParseDateTime(input)
+	return t, false, err
+}
+
+func marshalJSONString(value interface{ String() string }, isZero bool) ([]byte, error) {
+	if isZero {
+		return []byte("null"), nil
+	}
+	return json.Marshal(value.String())
+}
+
+// unmarshalJSONString decodes the JSON string that the target value is encoded as. The boolean is false
+// when there's nothing to parse, either because the JSON is null (and the error is nil) or because it's
+// not a string at all.
+func unmarshalJSONString(data []byte, target interface{}) (string, bool, error) {
+	if string(data) == "null" {
+		return "", false, nil
+	}
+	if len(data) == 0 || data[0] != '"' {
+		return "", false, &json.UnmarshalTypeError{Value: jsonKind(data), Type: reflect.TypeOf(target).Elem()}
+	}
+	var input string
+	if err := json.Unmarshal(data, &input); err != nil {
+		return "", false, err
+	}
+	return input, true, nil
+}
+
+// jsonKind describes the type of the raw JSON value the same way that the json package does in its errors.
+func jsonKind(data []byte) string {
+	if len(data) == 0 {
+		return "value"
+	}
+	switch data[0] {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case 't', 'f':
+		return "bool"
+	case '"':
+		return "string"
+	default:
+		return "number"
+	}
+}
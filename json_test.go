@@ -0,0 +1,171 @@
+package isodates_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestJSONSuite(t *testing.T) {
+	suite.Run(t, new(JSONSuite))
+}
+
+type JSONSuite struct {
+	ChronoSuite
+}
+
+type jsonReport struct {
+	Date      isodates.Date      `json:"date"`
+	Week      isodates.Week      `json:"week"`
+	WeekDay   isodates.WeekDay   `json:"week_day"`
+	YearMonth isodates.YearMonth `json:"year_month"`
+	MonthDay  isodates.MonthDay  `json:"month_day"`
+	Range     isodates.Range     `json:"range"`
+	Duration  isodates.Duration  `json:"duration"`
+}
+
+func (suite *JSONSuite) TestRoundTrip() {
+	report := jsonReport{
+		Date:      isodates.Date{Year: 2019, Month: time.May, Day: 22},
+		Week:      isodates.Week{Year: 2019, Week: 5},
+		WeekDay:   isodates.WeekDay{Year: 2019, Week: 5, Day: 3},
+		YearMonth: isodates.YearMonth{Year: 2019, Month: time.May},
+		MonthDay:  isodates.MonthDay{Month: time.December, Day: 25},
+		Range:     day(2019, time.May, 22),
+		Duration:  isodates.Duration{Days: 1, Clock: 12 * time.Hour},
+	}
+	data, err := json.Marshal(report)
+	suite.Require().NoError(err)
+	suite.JSONEq(`{
+		"date": "2019-05-22",
+		"week": "2019-W05",
+		"week_day": "2019-W05-3",
+		"year_month": "2019-05",
+		"month_day": "--12-25",
+		"range": {"start": "2019-05-22T00:00:00Z", "end": "2019-05-22T23:59:59.999999999Z"},
+		"duration": "P1DT12H"
+	}`, string(data))
+
+	var actual jsonReport
+	suite.Require().NoError(json.Unmarshal(data, &actual))
+	suite.Equal(report.Date, actual.Date)
+	suite.Equal(report.Week, actual.Week)
+	suite.Equal(report.WeekDay, actual.WeekDay)
+	suite.Equal(report.YearMonth, actual.YearMonth)
+	suite.Equal(report.MonthDay, actual.MonthDay)
+	suite.True(report.Range.Equal(actual.Range))
+	suite.Equal(report.Duration, actual.Duration)
+}
+
+func (suite *JSONSuite) TestRange() {
+	halfOpen := day(2019, time.May, 22).HalfOpen()
+	data, err := json.Marshal(halfOpen)
+	_ = suite.NoError(err) &&
+		suite.JSONEq(`{"start": "2019-05-22T00:00:00Z", "end": "2019-05-23T00:00:00Z", "exclusive": true}`, string(data))
+
+	var actual isodates.Range
+	_ = suite.NoError(json.Unmarshal(data, &actual)) && suite.True(halfOpen.Equal(actual))
+
+	// Local times keep their offsets, and still refer to the same instants.
+	local, _ := isodates.ParseDateRangeIn("2019-05-22", locationEDT)
+	data, err = json.Marshal(local)
+	_ = suite.NoError(err) &&
+		suite.JSONEq(`{"start": "2019-05-22T00:00:00-04:00", "end": "2019-05-22T23:59:59.999999999-04:00"}`, string(data))
+	_ = suite.NoError(json.Unmarshal(data, &actual)) && suite.True(local.Equal(actual))
+
+	// Unbounded sides are null, which is different from leaving the bound out entirely.
+	from2019 := isodates.Range{Start: time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC), UpperUnbounded: true}
+	data, err = json.Marshal(from2019)
+	_ = suite.NoError(err) && suite.JSONEq(`{"start": "2019-01-01T00:00:00Z", "end": null}`, string(data))
+	_ = suite.NoError(json.Unmarshal(data, &actual)) && suite.True(from2019.Equal(actual))
+
+	everything := isodates.Range{LowerUnbounded: true, UpperUnbounded: true}
+	data, err = json.Marshal(everything)
+	_ = suite.NoError(err) && suite.JSONEq(`{"start": null, "end": null}`, string(data))
+	_ = suite.NoError(json.Unmarshal(data, &actual)) && suite.True(everything.Equal(actual))
+}
+
+func (suite *JSONSuite) TestNull() {
+	data, err := json.Marshal(jsonReport{})
+	_ = suite.NoError(err) && suite.JSONEq(`{
+		"date": null,
+		"week": null,
+		"week_day": null,
+		"year_month": null,
+		"month_day": null,
+		"range": null,
+		"duration": null
+	}`, string(data))
+
+	var actual jsonReport
+	_ = suite.NoError(json.Unmarshal(data, &actual)) && suite.Equal(jsonReport{}, actual)
+
+	// Just like the standard library, null doesn't touch the existing value.
+	week := isodates.Week{Year: 2019, Week: 5}
+	_ = suite.NoError(json.Unmarshal([]byte("null"), &week)) && suite.Equal(isodates.Week{Year: 2019, Week: 5}, week)
+}
+
+func (suite *JSONSuite) TestParseErrors() {
+	fails := func(data string, component isodates.Component) {
+		var report jsonReport
+		err := json.Unmarshal([]byte(data), &report)
+
+		var parseErr *isodates.ParseError
+		_ = suite.True(errors.As(err, &parseErr), "%s: %v", data, err) &&
+			suite.Equal(string(component), string(parseErr.Component), data)
+	}
+	fails(`{"date": "2019-02-29"}`, isodates.ComponentDay)
+	fails(`{"week": "2019-W53"}`, isodates.ComponentWeek)
+	fails(`{"week_day": "2019-W05-8"}`, isodates.ComponentOffset)
+	fails(`{"year_month": "2019-13"}`, isodates.ComponentMonth)
+	fails(`{"month_day": "--02-30"}`, isodates.ComponentDay)
+	fails(`{"week": "2019-W5"}`, "")
+	fails(`{"duration": "P1X"}`, "")
+	fails(`{"range": {"start": "2019-05-22"}}`, "")
+	fails(`{"range": {"start": "2019-05-22T00:00:00Z"}}`, "")
+
+	var report jsonReport
+	err := json.Unmarshal([]byte(`{"week": "2019-W5"}`), &report)
+	suite.EqualError(err, "invalid YYYY-W## format: 2019-W5")
+}
+
+func (suite *JSONSuite) TestTypeErrors() {
+	fails := func(data string, typeName string) {
+		var report jsonReport
+		err := json.Unmarshal([]byte(data), &report)
+
+		var typeErr *json.UnmarshalTypeError
+		_ = suite.True(errors.As(err, &typeErr), "%s: %v", data, err) &&
+			suite.Equal(typeName, typeErr.Type.String(), data)
+	}
+	fails(`{"date": 20190522}`, "isodates.Date")
+	fails(`{"week": true}`, "isodates.Week")
+	fails(`{"week_day": ["2019-W05-3"]}`, "isodates.WeekDay")
+	fails(`{"year_month": {}}`, "isodates.YearMonth")
+	fails(`{"month_day": 1225}`, "isodates.MonthDay")
+	fails(`{"range": "2019-05-22"}`, "isodates.Range")
+	fails(`{"duration": 86400}`, "isodates.Duration")
+	fails(`{"range": {"start": 5}}`, "string")
+}
+
+func ExampleWeek_UnmarshalJSON() {
+	var request struct {
+		Period isodates.Week `json:"period"`
+	}
+	if err := json.Unmarshal([]byte(`{"period": "2019-W05"}`), &request); err != nil {
+		panic(err)
+	}
+	fmt.Println(request.Period.Start(time.UTC))
+
+	err := json.Unmarshal([]byte(`{"period": "2019-W53"}`), &request)
+	fmt.Println(err)
+
+	// Output:
+	// 2019-01-28 00:00:00 +0000 UTC
+	// invalid week number: 53 (2019 has 52 weeks)
+}
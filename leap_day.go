@@ -0,0 +1,207 @@
+package isodates
+
+import (
+	"fmt"
+	"time"
+)
+
+// LeapDayPolicy determines what happens when a February 29th month/day (e.g. "--02-29") is
+// resolved in a year that is not a leap year. This comes up a lot with birthdays and anniversaries
+// that are stored as month/day values.
+type LeapDayPolicy int
+
+const (
+	// LeapDayMarch1 moves Feb 29th to March 1st in non-leap years. This is the policy used by the
+	// package-level functions such as ParseMonthDayStart(); it's what you get from time.Date().
+	LeapDayMarch1 LeapDayPolicy = iota
+	// LeapDayFebruary28 moves Feb 29th back to February 28th in non-leap years.
+	LeapDayFebruary28
+	// LeapDayError treats Feb 29th in a non-leap year as an error.
+	LeapDayError
+)
+
+// Resolve applies the policy to the month/day in the given year. Any date other than Feb 29th in
+// a non-leap year is returned as-is. For LeapDayError, Feb 29th in a non-leap year results in a
+// *ParseError for the day with ReasonNonexistent.
+func (policy LeapDayPolicy) Resolve(year int, month time.Month, day int) (time.Month, int, error) {
+	if month != time.February || day != 29 || DaysInMonth(year, time.February) == 29 {
+		return month, day, nil
+	}
+
+	switch policy {
+	case LeapDayMarch1:
+		return time.March, 1, nil
+	case LeapDayFebruary28:
+		return time.February, 28, nil
+	case LeapDayError:
+		return ZeroMonth, 0, leapDayError(ExtendedFormat.monthDay(month, day), year)
+	default:
+		return ZeroMonth, 0, policy.validate()
+	}
+}
+
+// ParseMonthDayStart parses the month/day string (e.g. "--02-29") and returns a date/time at
+// midnight in the specified year, applying the policy for leap days. The resulting timestamp
+// will be in UTC.
+func (policy LeapDayPolicy) ParseMonthDayStart(input string, year int) (time.Time, error) {
+	return policy.ParseMonthDayStartIn(input, year, time.UTC)
+}
+
+// ParseMonthDayStartIn parses the month/day string (e.g. "--02-29") and returns a date/time at
+// midnight in the specified year, applying the policy for leap days. The resulting timestamp
+// will be in the specified time zone.
+func (policy LeapDayPolicy) ParseMonthDayStartIn(input string, year int, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse month day start: %w", ErrNilLocation)
+	}
+	month, day, err := policy.parseMonthDayIn(input, year)
+	if err != nil {
+		return ZeroTime, err
+	}
+	return Midnight(year, month, day, loc), nil
+}
+
+// ParseMonthDayEnd parses the month/day string (e.g. "--02-29") and returns a date/time at
+// 11:59:59pm in the specified year, applying the policy for leap days. The resulting timestamp
+// will be in UTC.
+func (policy LeapDayPolicy) ParseMonthDayEnd(input string, year int) (time.Time, error) {
+	return policy.ParseMonthDayEndIn(input, year, time.UTC)
+}
+
+// ParseMonthDayEndIn parses the month/day string (e.g. "--02-29") and returns a date/time at
+// 11:59:59pm in the specified year, applying the policy for leap days. The resulting timestamp
+// will be in the specified time zone.
+func (policy LeapDayPolicy) ParseMonthDayEndIn(input string, year int, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse month day end: %w", ErrNilLocation)
+	}
+	month, day, err := policy.parseMonthDayIn(input, year)
+	if err != nil {
+		return ZeroTime, err
+	}
+	return AlmostMidnight(year, month, day, loc), nil
+}
+
+// ParseMonthDayEndExclusive parses the month/day string (e.g. "--02-29") and returns a date/time at
+// midnight on the following day in the specified year, applying the policy for leap days. The resulting
+// timestamp will be in UTC.
+func (policy LeapDayPolicy) ParseMonthDayEndExclusive(input string, year int) (time.Time, error) {
+	return policy.ParseMonthDayEndExclusiveIn(input, year, time.UTC)
+}
+
+// ParseMonthDayEndExclusiveIn parses the month/day string (e.g. "--02-29") and returns a date/time at
+// midnight on the following day in the specified year, applying the policy for leap days. The resulting
+// timestamp will be in the specified time zone.
+func (policy LeapDayPolicy) ParseMonthDayEndExclusiveIn(input string, year int, loc *time.Location) (time.Time, error) {
+	return exclusiveEnd(policy.ParseMonthDayEndIn(input, year, loc))
+}
+
+// ParseMonthDayRange parses the month/day string (e.g. "--02-29") and returns the range from midnight
+// through 11:59:59pm on that day in the specified year, applying the policy for leap days. The resulting
+// range will be in UTC.
+func (policy LeapDayPolicy) ParseMonthDayRange(input string, year int) (Range, error) {
+	return policy.ParseMonthDayRangeIn(input, year, time.UTC)
+}
+
+// ParseMonthDayRangeIn parses the month/day string (e.g. "--02-29") and returns the range from midnight
+// through 11:59:59pm on that day in the specified year, applying the policy for leap days. The resulting
+// range will be in the specified time zone.
+func (policy LeapDayPolicy) ParseMonthDayRangeIn(input string, year int, loc *time.Location) (Range, error) {
+	if loc == nil {
+		return Range{}, fmt.Errorf("parse month day range: %w", ErrNilLocation)
+	}
+	month, day, err := policy.parseMonthDayIn(input, year)
+	if err != nil {
+		return Range{}, err
+	}
+	return Range{Start: Midnight(year, month, day, loc), End: AlmostMidnight(year, month, day, loc)}, nil
+}
+
+// parseMonthDayIn parses the month/day string and resolves it to an actual date in the given year.
+func (policy LeapDayPolicy) parseMonthDayIn(input string, year int) (time.Month, int, error) {
+	month, day, err := ParseMonthDay(input)
+	if err != nil {
+		return ZeroMonth, 0, err
+	}
+	month, day, err = policy.Resolve(year, month, day)
+	if _, ok := err.(*ParseError); ok {
+		// Report the problem against the input we were actually given (e.g. "--2-29") rather than "--02-29".
+		return ZeroMonth, 0, leapDayError(input, year)
+	}
+	return month, day, err
+}
+
+// leapDayError indicates that the Feb 29th month/day input doesn't exist in the (non-leap) year.
+func leapDayError(input string, year int) *ParseError {
+	detail := fmt.Sprintf("February 29 does not exist in %d", year)
+	return nonexistentError("--MM-DD", input, ComponentDay, monthDayStart(input), len(input), detail)
+}
+
+// NextMonthDayOccurrence finds the next time that the month/day string (e.g. "--12-25") occurs on or after
+// the reference time and returns midnight and 11:59:59pm of that day in the given time zone. The reference
+// time is converted to that time zone first, so "today" is based on the local calendar date there. If
+// today is that month/day, you get today's range.
+//
+// Feb 29th is resolved using the policy in each candidate year. For LeapDayError, that means that only
+// actual leap days count, so you get the next Feb 29th that exists rather than an error.
+func (policy LeapDayPolicy) NextMonthDayOccurrence(input string, ref time.Time, loc *time.Location) (start time.Time, end time.Time, err error) {
+	if loc == nil {
+		return ZeroTime, ZeroTime, fmt.Errorf("next month day occurrence: %w", ErrNilLocation)
+	}
+	return policy.findMonthDayOccurrence(input, ref.In(loc), 1)
+}
+
+// PreviousMonthDayOccurrence finds the most recent time that the month/day string (e.g. "--12-25") occurred
+// on or before the reference time and returns midnight and 11:59:59pm of that day in the given time zone. The
+// reference time is converted to that time zone first, so "today" is based on the local calendar date there.
+// If today is that month/day, you get today's range.
+//
+// Feb 29th is resolved using the policy in each candidate year. For LeapDayError, that means that only
+// actual leap days count, so you get the most recent Feb 29th that existed rather than an error.
+func (policy LeapDayPolicy) PreviousMonthDayOccurrence(input string, ref time.Time, loc *time.Location) (start time.Time, end time.Time, err error) {
+	if loc == nil {
+		return ZeroTime, ZeroTime, fmt.Errorf("previous month day occurrence: %w", ErrNilLocation)
+	}
+	return policy.findMonthDayOccurrence(input, ref.In(loc), -1)
+}
+
+// findMonthDayOccurrence walks year by year from today's year in the given direction (1 or -1) until it
+// finds a year where the month/day lands on or after (or on or before) today.
+func (policy LeapDayPolicy) findMonthDayOccurrence(input string, today time.Time, direction int) (time.Time, time.Time, error) {
+	if err := policy.validate(); err != nil {
+		return ZeroTime, ZeroTime, err
+	}
+	month, day, err := ParseMonthDay(input)
+	if err != nil {
+		return ZeroTime, ZeroTime, err
+	}
+
+	todayNumber := monthDayNumber(today.Month(), today.Day())
+
+	// Leap years are never more than 8 years apart (e.g. 2096 -> 2104), so we'll find one by then.
+	for i := 0; i <= 8; i++ {
+		year := today.Year() + i*direction
+		actualMonth, actualDay, err := policy.Resolve(year, month, day)
+		if err != nil {
+			continue
+		}
+		number := monthDayNumber(actualMonth, actualDay)
+		if i > 0 || (direction > 0 && number >= todayNumber) || (direction < 0 && number <= todayNumber) {
+			loc := today.Location()
+			return Midnight(year, actualMonth, actualDay, loc), AlmostMidnight(year, actualMonth, actualDay, loc), nil
+		}
+	}
+	return ZeroTime, ZeroTime, fmt.Errorf("unable to find an occurrence of month/day: %s", input)
+}
+
+func (policy LeapDayPolicy) validate() error {
+	if policy < LeapDayMarch1 || policy > LeapDayError {
+		return fmt.Errorf("invalid leap day policy: %d", policy)
+	}
+	return nil
+}
+
+// monthDayNumber converts the month/day into a single number that sorts in calendar order.
+func monthDayNumber(month time.Month, day int) int {
+	return int(month)*100 + day
+}
@@ -0,0 +1,252 @@
+package isodates_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestLeapDaySuite(t *testing.T) {
+	suite.Run(t, new(LeapDaySuite))
+}
+
+type LeapDaySuite struct {
+	ChronoSuite
+}
+
+func (suite *LeapDaySuite) TestResolve() {
+	succeeds := func(policy isodates.LeapDayPolicy, year int, month time.Month, day int, expectedMonth time.Month, expectedDay int) {
+		actualMonth, actualDay, err := policy.Resolve(year, month, day)
+		_ = suite.NoError(err) &&
+			suite.Equal(expectedMonth, actualMonth) &&
+			suite.Equal(expectedDay, actualDay)
+	}
+	fails := func(policy isodates.LeapDayPolicy, year int, month time.Month, day int) {
+		_, _, err := policy.Resolve(year, month, day)
+		suite.Error(err)
+	}
+	fails(isodates.LeapDayError, 2019, time.February, 29)
+	fails(isodates.LeapDayError, 1900, time.February, 29)
+	fails(isodates.LeapDayPolicy(99), 2019, time.February, 29)
+
+	// Leap day errors are structured just like parse errors so you can tell them apart.
+	_, _, err := isodates.LeapDayError.Resolve(2019, time.February, 29)
+	var parseErr *isodates.ParseError
+	_ = suite.True(errors.As(err, &parseErr)) &&
+		suite.Equal(string(isodates.ComponentDay), string(parseErr.Component)) &&
+		suite.Equal(string(isodates.ReasonNonexistent), string(parseErr.Reason)) &&
+		suite.Equal("--02-29", parseErr.Input) &&
+		suite.Equal("29", parseErr.Value)
+
+	_, err = isodates.LeapDayError.ParseMonthDayStart("--2-29", 2019)
+	_ = suite.True(errors.As(err, &parseErr)) &&
+		suite.Equal("--2-29", parseErr.Input) &&
+		suite.Equal(4, parseErr.Offset) &&
+		suite.Equal(string(isodates.ReasonNonexistent), string(parseErr.Reason))
+
+	_, err = isodates.LeapDayError.ParseMonthDayStart("--0229", 2019)
+	_ = suite.True(errors.As(err, &parseErr)) &&
+		suite.Equal(4, parseErr.Offset) &&
+		suite.Equal("29", parseErr.Value)
+
+	// Leap years are never affected
+	succeeds(isodates.LeapDayMarch1, 2020, time.February, 29, time.February, 29)
+	succeeds(isodates.LeapDayFebruary28, 2020, time.February, 29, time.February, 29)
+	succeeds(isodates.LeapDayError, 2000, time.February, 29, time.February, 29)
+
+	// Other dates are never affected
+	succeeds(isodates.LeapDayError, 2019, time.February, 28, time.February, 28)
+	succeeds(isodates.LeapDayFebruary28, 2019, time.March, 1, time.March, 1)
+	succeeds(isodates.LeapDayPolicy(99), 2019, time.March, 1, time.March, 1)
+
+	succeeds(isodates.LeapDayMarch1, 2019, time.February, 29, time.March, 1)
+	succeeds(isodates.LeapDayFebruary28, 2019, time.February, 29, time.February, 28)
+	succeeds(isodates.LeapDayFebruary28, 2100, time.February, 29, time.February, 28)
+}
+
+func (suite *LeapDaySuite) TestParseMonthDayStart() {
+	succeeds := func(policy isodates.LeapDayPolicy, input string, inputYear int, year int, month time.Month, day int) {
+		date, err := policy.ParseMonthDayStart(input, inputYear)
+		suite.AssertMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(policy isodates.LeapDayPolicy, input string, inputYear int) {
+		_, err := policy.ParseMonthDayStart(input, inputYear)
+		suite.Error(err)
+	}
+	fails(isodates.LeapDayMarch1, "", 2019)
+	fails(isodates.LeapDayMarch1, "--02-30", 2019)
+	fails(isodates.LeapDayError, "--02-29", 2019)
+
+	succeeds(isodates.LeapDayMarch1, "--12-25", 2019, 2019, time.December, 25)
+	succeeds(isodates.LeapDayMarch1, "--02-29", 2019, 2019, time.March, 1)
+	succeeds(isodates.LeapDayMarch1, "--02-29", 2020, 2020, time.February, 29)
+	succeeds(isodates.LeapDayFebruary28, "--02-29", 2019, 2019, time.February, 28)
+	succeeds(isodates.LeapDayFebruary28, "--02-29", 2020, 2020, time.February, 29)
+	succeeds(isodates.LeapDayError, "--02-29", 2020, 2020, time.February, 29)
+}
+
+func (suite *LeapDaySuite) TestParseMonthDayStartIn() {
+	succeeds := func(policy isodates.LeapDayPolicy, input string, inputYear int, loc *time.Location, year int, month time.Month, day int) {
+		date, err := policy.ParseMonthDayStartIn(input, inputYear, loc)
+		suite.AssertMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(policy isodates.LeapDayPolicy, input string, inputYear int, loc *time.Location) {
+		_, err := policy.ParseMonthDayStartIn(input, inputYear, loc)
+		suite.Error(err)
+	}
+	fails(isodates.LeapDayMarch1, "", 2019, locationEDT)
+	fails(isodates.LeapDayMarch1, "--02-28", 2019, nil)
+	fails(isodates.LeapDayError, "--02-29", 2019, locationEDT)
+
+	succeeds(isodates.LeapDayMarch1, "--02-29", 2019, locationEDT, 2019, time.March, 1)
+	succeeds(isodates.LeapDayFebruary28, "--02-29", 2019, locationPDT, 2019, time.February, 28)
+	succeeds(isodates.LeapDayError, "--02-29", 2020, locationEDT, 2020, time.February, 29)
+}
+
+func (suite *LeapDaySuite) TestParseMonthDayEnd() {
+	succeeds := func(policy isodates.LeapDayPolicy, input string, inputYear int, year int, month time.Month, day int) {
+		date, err := policy.ParseMonthDayEnd(input, inputYear)
+		suite.AssertAlmostMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(policy isodates.LeapDayPolicy, input string, inputYear int) {
+		_, err := policy.ParseMonthDayEnd(input, inputYear)
+		suite.Error(err)
+	}
+	fails(isodates.LeapDayMarch1, "", 2019)
+	fails(isodates.LeapDayMarch1, "--02-30", 2019)
+	fails(isodates.LeapDayError, "--02-29", 2019)
+
+	succeeds(isodates.LeapDayMarch1, "--02-29", 2019, 2019, time.March, 1)
+	succeeds(isodates.LeapDayFebruary28, "--02-29", 2019, 2019, time.February, 28)
+	succeeds(isodates.LeapDayError, "--02-29", 2020, 2020, time.February, 29)
+}
+
+func (suite *LeapDaySuite) TestParseMonthDayEndIn() {
+	succeeds := func(policy isodates.LeapDayPolicy, input string, inputYear int, loc *time.Location, year int, month time.Month, day int) {
+		date, err := policy.ParseMonthDayEndIn(input, inputYear, loc)
+		suite.AssertAlmostMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(policy isodates.LeapDayPolicy, input string, inputYear int, loc *time.Location) {
+		_, err := policy.ParseMonthDayEndIn(input, inputYear, loc)
+		suite.Error(err)
+	}
+	fails(isodates.LeapDayMarch1, "", 2019, locationEDT)
+	fails(isodates.LeapDayMarch1, "--02-28", 2019, nil)
+	fails(isodates.LeapDayError, "--02-29", 2019, locationEDT)
+
+	succeeds(isodates.LeapDayMarch1, "--02-29", 2019, locationEDT, 2019, time.March, 1)
+	succeeds(isodates.LeapDayFebruary28, "--02-29", 2019, locationPDT, 2019, time.February, 28)
+	succeeds(isodates.LeapDayError, "--02-29", 2020, locationEDT, 2020, time.February, 29)
+}
+
+func (suite *LeapDaySuite) TestParseMonthDayEndExclusiveIn() {
+	_, err := isodates.LeapDayError.ParseMonthDayEndExclusiveIn("--02-29", 2019, locationEDT)
+	suite.Error(err)
+	_, err = isodates.LeapDayMarch1.ParseMonthDayEndExclusiveIn("--02-28", 2019, nil)
+	suite.Error(err)
+
+	date, err := isodates.LeapDayFebruary28.ParseMonthDayEndExclusiveIn("--02-29", 2019, locationEDT)
+	suite.AssertMidnightIn(date, err, 2019, time.March, 1, locationEDT)
+
+	date, err = isodates.LeapDayError.ParseMonthDayEndExclusive("--02-29", 2020)
+	suite.AssertMidnightUTC(date, err, 2020, time.March, 1)
+}
+
+func (suite *LeapDaySuite) TestParseMonthDayRangeIn() {
+	succeeds := func(policy isodates.LeapDayPolicy, input string, inputYear int, loc *time.Location, year int, month time.Month, day int) {
+		r, err := policy.ParseMonthDayRangeIn(input, inputYear, loc)
+		suite.AssertRangeIn(r, err, year, month, day, year, month, day, loc)
+	}
+	fails := func(policy isodates.LeapDayPolicy, input string, inputYear int, loc *time.Location) {
+		_, err := policy.ParseMonthDayRangeIn(input, inputYear, loc)
+		suite.Error(err)
+	}
+	fails(isodates.LeapDayMarch1, "", 2019, locationEDT)
+	fails(isodates.LeapDayMarch1, "--02-28", 2019, nil)
+	fails(isodates.LeapDayError, "--02-29", 2019, locationEDT)
+
+	succeeds(isodates.LeapDayMarch1, "--02-29", 2019, locationEDT, 2019, time.March, 1)
+	succeeds(isodates.LeapDayFebruary28, "--02-29", 2019, locationPDT, 2019, time.February, 28)
+	succeeds(isodates.LeapDayError, "--02-29", 2020, locationEDT, 2020, time.February, 29)
+
+	r, err := isodates.LeapDayFebruary28.ParseMonthDayRange("--02-29", 2019)
+	suite.AssertRangeIn(r, err, 2019, time.February, 28, 2019, time.February, 28, time.UTC)
+}
+
+func (suite *LeapDaySuite) TestNextMonthDayOccurrence() {
+	succeeds := func(policy isodates.LeapDayPolicy, input string, ref time.Time, year int, month time.Month, day int) {
+		start, end, err := policy.NextMonthDayOccurrence(input, ref, locationEDT)
+		_ = suite.AssertMidnightIn(start, err, year, month, day, locationEDT) &&
+			suite.AssertAlmostMidnightIn(end, err, year, month, day, locationEDT)
+	}
+	fails := func(policy isodates.LeapDayPolicy, input string, ref time.Time, loc *time.Location) {
+		_, _, err := policy.NextMonthDayOccurrence(input, ref, loc)
+		suite.Error(err)
+	}
+	ref := time.Date(2019, time.June, 15, 12, 0, 0, 0, locationEDT)
+	fails(isodates.LeapDayError, "", ref, locationEDT)
+	fails(isodates.LeapDayError, "--02-29", ref, nil)
+	fails(isodates.LeapDayPolicy(99), "--02-29", ref, locationEDT)
+
+	succeeds(isodates.LeapDayMarch1, "--02-29", ref, 2020, time.February, 29)
+	succeeds(isodates.LeapDayFebruary28, "--02-29", ref, 2020, time.February, 29)
+	succeeds(isodates.LeapDayError, "--02-29", ref, 2020, time.February, 29)
+
+	ref = time.Date(2020, time.March, 1, 12, 0, 0, 0, locationEDT)
+	succeeds(isodates.LeapDayMarch1, "--02-29", ref, 2021, time.March, 1)
+	succeeds(isodates.LeapDayFebruary28, "--02-29", ref, 2021, time.February, 28)
+	succeeds(isodates.LeapDayError, "--02-29", ref, 2024, time.February, 29)
+
+	// Feb 28th in a non-leap year is "today" for the February 28th policy.
+	ref = time.Date(2021, time.February, 28, 12, 0, 0, 0, locationEDT)
+	succeeds(isodates.LeapDayFebruary28, "--02-29", ref, 2021, time.February, 28)
+	succeeds(isodates.LeapDayMarch1, "--02-29", ref, 2021, time.March, 1)
+
+	// There's an 8 year gap around 2100 since it's not a leap year.
+	ref = time.Date(2097, time.January, 1, 12, 0, 0, 0, locationEDT)
+	succeeds(isodates.LeapDayError, "--02-29", ref, 2104, time.February, 29)
+}
+
+func (suite *LeapDaySuite) TestPreviousMonthDayOccurrence() {
+	succeeds := func(policy isodates.LeapDayPolicy, input string, ref time.Time, year int, month time.Month, day int) {
+		start, end, err := policy.PreviousMonthDayOccurrence(input, ref, locationEDT)
+		_ = suite.AssertMidnightIn(start, err, year, month, day, locationEDT) &&
+			suite.AssertAlmostMidnightIn(end, err, year, month, day, locationEDT)
+	}
+	fails := func(policy isodates.LeapDayPolicy, input string, ref time.Time, loc *time.Location) {
+		_, _, err := policy.PreviousMonthDayOccurrence(input, ref, loc)
+		suite.Error(err)
+	}
+	ref := time.Date(2019, time.June, 15, 12, 0, 0, 0, locationEDT)
+	fails(isodates.LeapDayError, "", ref, locationEDT)
+	fails(isodates.LeapDayError, "--02-29", ref, nil)
+	fails(isodates.LeapDayPolicy(99), "--02-29", ref, locationEDT)
+
+	succeeds(isodates.LeapDayMarch1, "--02-29", ref, 2019, time.March, 1)
+	succeeds(isodates.LeapDayFebruary28, "--02-29", ref, 2019, time.February, 28)
+	succeeds(isodates.LeapDayError, "--02-29", ref, 2016, time.February, 29)
+
+	ref = time.Date(2020, time.February, 29, 12, 0, 0, 0, locationEDT)
+	succeeds(isodates.LeapDayError, "--02-29", ref, 2020, time.February, 29)
+
+	// There's an 8 year gap around 2100 since it's not a leap year.
+	ref = time.Date(2103, time.December, 31, 12, 0, 0, 0, locationEDT)
+	succeeds(isodates.LeapDayError, "--02-29", ref, 2096, time.February, 29)
+}
+
+func ExampleLeapDayPolicy_ParseMonthDayStart() {
+	march1, _ := isodates.LeapDayMarch1.ParseMonthDayStart("--02-29", 2019)
+	feb28, _ := isodates.LeapDayFebruary28.ParseMonthDayStart("--02-29", 2019)
+	_, err := isodates.LeapDayError.ParseMonthDayStart("--02-29", 2019)
+	fmt.Println(march1.Format("Jan 2"))
+	fmt.Println(feb28.Format("Jan 2"))
+	fmt.Println(err)
+
+	// Output: Mar 1
+	// Feb 28
+	// invalid day of month: 29 (February 29 does not exist in 2019)
+}
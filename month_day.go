@@ -1,48 +1,90 @@
 package isodates
 
 import (
-	"errors"
+	"fmt"
+	"strings"
 	"time"
 )
 
 // ParseMonthDay accepts an ISO-formatted month/day string (e.g. "--04-01" is April, 1) and returns the
-// month and day that it represents.
+// month and day that it represents. The day must exist in that month in at least some year, so "--02-29"
+// is valid but "--02-30" or "--04-31" result in an error. Use ParseMonthDayLenient if you would rather
+// roll those over into the following month.
 func ParseMonthDay(input string) (time.Month, int, error) {
-	var monthText, dayText string
+	month, day, err := parseMonthDay(input)
+	if err != nil {
+		return ZeroMonth, 0, err
+	}
+	// Year 2000 was a leap year, so this gives us the most days that the month can ever have.
+	if daysInMonth := DaysInMonth(2000, month); day > daysInMonth {
+		detail := fmt.Sprintf("%s has at most %d days", month, daysInMonth)
+		return ZeroMonth, 0, nonexistentError("--MM-DD", input, ComponentDay, monthDayStart(input), len(input), detail)
+	}
+	return month, day, nil
+}
+
+// ParseMonthDayLenient accepts an ISO-formatted month/day string (e.g. "--04-01" is April, 1) and returns
+// the month and day that it represents. Unlike ParseMonthDay, days past the end of the month roll over
+// into the following month(s) as if the year were a leap year (e.g. "--01-32" is February 1st and
+// "--02-30" is March 1st).
+func ParseMonthDayLenient(input string) (time.Month, int, error) {
+	month, day, err := parseMonthDay(input)
+	if err != nil {
+		return ZeroMonth, 0, err
+	}
+
+	// You have a potential for something like January 32nd which is actually Feb 1st.
+	if day > 28 {
+		d := time.Date(2000, month, day, 0, 0, 0, 0, time.UTC)
+		return d.Month(), d.Day(), nil
+	}
+	return month, day, nil
+}
+
+// parseMonthDay extracts the raw month and day components from the month/day string without
+// checking whether that day actually exists in the month.
+func parseMonthDay(input string) (time.Month, int, error) {
+	var monthEnd, dayStart int
 	inputLength := len(input)
 
 	switch {
-	// All valid inputs are between 5 and 7 chars: "--3-1", "--03-1", "--03-01"
-	case inputLength < 5 || inputLength > 7:
-		return time.Month(0), 0, errors.New("invalid iso month/day: " + input)
+	// All valid inputs are between 5 and 7 chars: "--3-1", "--03-1", "--03-01", or the basic "--0301"
+	case inputLength < 5:
+		return ZeroMonth, 0, formatError("--MM-DD", input, inputLength)
+	case inputLength > 7:
+		return ZeroMonth, 0, formatError("--MM-DD", input, 7)
 	// Month not padded: e.g. "--3-27", "--3-05", or "--3-5"
 	case input[3] == '-':
-		monthText = input[2:3]
-		dayText = input[4:]
+		monthEnd, dayStart = 3, 4
 	// Month *is* padded: e.g. "--03-27", "--03-05", or "--03-5"
 	case input[4] == '-':
-		monthText = input[2:4]
-		dayText = input[5:]
+		monthEnd, dayStart = 4, 5
+	// Basic format with no separator between the padded month and day: e.g. "--0327"
+	case inputLength == 6:
+		monthEnd, dayStart = 4, 4
 	default:
-		return time.Month(0), 0, errors.New("invalid iso month/day: " + input)
+		return ZeroMonth, 0, formatError("--MM-DD", input, 4)
 	}
 
-	month, err := parseMonth(monthText)
+	month, err := parseMonth("--MM-DD", input, 2, monthEnd)
 	if err != nil {
 		return ZeroMonth, 0, err
 	}
 
-	day, err := parseDayOfMonth(dayText)
+	day, err := parseDayOfMonth("--MM-DD", input, dayStart, inputLength)
 	if err != nil {
 		return ZeroMonth, 0, err
 	}
+	return month, day, nil
+}
 
-	// You have a potential for something like January 32nd which is actually Feb 1st.
-	if day > 28 {
-		d := time.Date(2000, time.Month(month), int(day), 0, 0, 0, 0, time.UTC)
-		return d.Month(), d.Day(), nil
+// monthDayStart returns the offset of the day in a month/day string. That's right after the last '-'
+// unless it's the basic format (e.g. "--0522") where the day is the last 2 characters.
+func monthDayStart(input string) int {
+	if dayStart := strings.LastIndexByte(input, '-') + 1; dayStart > 2 {
+		return dayStart
 	}
-	return time.Month(month), int(day), nil
+	return len(input) - 2
 }
 
 // ParseMonthDayStart parses the month/day string (e.g. "--12-24") and returns a date/time at
@@ -52,16 +94,17 @@ func ParseMonthDayStart(input string, year int) (time.Time, error) {
 }
 
 // ParseMonthDayStartIn parses the month/day string (e.g. "--12-24") and returns a date/time at
-// midnight in the specified year. The resulting timestamp will be in specified time zone.
+// midnight in the specified year. The resulting timestamp will be in specified time zone. Feb 29th
+// moves to March 1st in non-leap years; use a LeapDayPolicy if you want something else.
 func ParseMonthDayStartIn(input string, year int, loc *time.Location) (time.Time, error) {
 	if loc == nil {
-		return ZeroTime, errors.New("parse month day start: nil location")
+		return ZeroTime, fmt.Errorf("parse month day start: %w", ErrNilLocation)
 	}
-	month, day, err := ParseMonthDay(input)
+	monthDay, err := ParseMonthDayValue(input)
 	if err != nil {
 		return ZeroTime, err
 	}
-	return Midnight(year, month, day, loc), nil
+	return monthDay.Start(year, loc), nil
 }
 
 // ParseMonthDayEnd parses the month/day string (e.g. "--12-24") and returns a date/time at
@@ -71,14 +114,64 @@ func ParseMonthDayEnd(input string, year int) (time.Time, error) {
 }
 
 // ParseMonthDayEndIn parses the month/day string (e.g. "--12-24") and returns a date/time at
-// 11:59:59pm in the specified year. The resulting timestamp will be in the specified time zone.
+// 11:59:59pm in the specified year. The resulting timestamp will be in the specified time zone. Feb 29th
+// moves to March 1st in non-leap years; use a LeapDayPolicy if you want something else.
 func ParseMonthDayEndIn(input string, year int, loc *time.Location) (time.Time, error) {
 	if loc == nil {
-		return ZeroTime, errors.New("parse month day end: nil location")
+		return ZeroTime, fmt.Errorf("parse month day end: %w", ErrNilLocation)
 	}
-	month, day, err := ParseMonthDay(input)
+	monthDay, err := ParseMonthDayValue(input)
 	if err != nil {
 		return ZeroTime, err
 	}
-	return AlmostMidnight(year, month, day, loc), nil
+	return monthDay.End(year, loc), nil
+}
+
+// ParseMonthDayEndExclusive parses the month/day string (e.g. "--12-24") and returns a date/time at
+// midnight on the following day in the specified year. The resulting timestamp will be in UTC.
+func ParseMonthDayEndExclusive(input string, year int) (time.Time, error) {
+	return ParseMonthDayEndExclusiveIn(input, year, time.UTC)
+}
+
+// ParseMonthDayEndExclusiveIn parses the month/day string (e.g. "--12-24") and returns a date/time at
+// midnight on the following day in the specified year and time zone. Feb 29th moves to March 1st in
+// non-leap years; use a LeapDayPolicy if you want something else.
+func ParseMonthDayEndExclusiveIn(input string, year int, loc *time.Location) (time.Time, error) {
+	return exclusiveEnd(ParseMonthDayEndIn(input, year, loc))
+}
+
+// ParseMonthDayRange parses the month/day string (e.g. "--12-24") and returns the range from midnight
+// through 11:59:59pm on that day in the specified year. The resulting range will be in UTC.
+func ParseMonthDayRange(input string, year int) (Range, error) {
+	return ParseMonthDayRangeIn(input, year, time.UTC)
+}
+
+// ParseMonthDayRangeIn parses the month/day string (e.g. "--12-24") and returns the range from midnight
+// through 11:59:59pm on that day in the specified year. The resulting range will be in the specified time
+// zone. Feb 29th moves to March 1st in non-leap years; use a LeapDayPolicy if you want something else.
+func ParseMonthDayRangeIn(input string, year int, loc *time.Location) (Range, error) {
+	if loc == nil {
+		return Range{}, fmt.Errorf("parse month day range: %w", ErrNilLocation)
+	}
+	monthDay, err := ParseMonthDayValue(input)
+	if err != nil {
+		return Range{}, err
+	}
+	return monthDay.Range(year, loc), nil
+}
+
+// NextMonthDayOccurrence finds the next time that the month/day string (e.g. "--12-25") occurs on or
+// after the reference time and returns midnight and 11:59:59pm of that day in the given time zone. If
+// today (in that time zone) is that month/day, you get today's range. Feb 29th moves to March 1st in
+// non-leap years; use a LeapDayPolicy if you want something else.
+func NextMonthDayOccurrence(input string, ref time.Time, loc *time.Location) (start time.Time, end time.Time, err error) {
+	return LeapDayMarch1.NextMonthDayOccurrence(input, ref, loc)
+}
+
+// PreviousMonthDayOccurrence finds the most recent time that the month/day string (e.g. "--12-25")
+// occurred on or before the reference time and returns midnight and 11:59:59pm of that day in the given
+// time zone. If today (in that time zone) is that month/day, you get today's range. Feb 29th moves to
+// March 1st in non-leap years; use a LeapDayPolicy if you want something else.
+func PreviousMonthDayOccurrence(input string, ref time.Time, loc *time.Location) (start time.Time, end time.Time, err error) {
+	return LeapDayMarch1.PreviousMonthDayOccurrence(input, ref, loc)
 }
@@ -61,8 +61,49 @@ func (suite *MonthDaySuite) TestParseMonthDay() {
 	succeeds("--12-1", time.December, 1)
 	succeeds("--12-01", time.December, 1)
 	succeeds("--12-27", time.December, 27)
+	succeeds("--12-31", time.December, 31)
+
+	// Impossible days don't silently roll over to subsequent months
+	fails("--01-32")
+	fails("--02-30")
+	fails("--04-31")
+	fails("--05-65")
+
+	// Feb 29th exists in leap years, so it's a valid month/day
+	succeeds("--02-28", time.February, 28)
+	succeeds("--02-29", time.February, 29)
+
+	// Basic format
+	fails("--0230")
+	fails("--1301")
+	fails("--05x2")
+	succeeds("--0522", time.May, 22)
+	succeeds("--0229", time.February, 29)
+}
+
+func (suite *MonthDaySuite) TestParseMonthDayLenient() {
+	succeeds := func(input string, expectedMonth time.Month, expectedDate int) {
+		month, date, err := isodates.ParseMonthDayLenient(input)
+		_ = suite.NoError(err) &&
+			suite.Equal(expectedMonth, month) &&
+			suite.Equal(expectedDate, date)
+	}
+	fails := func(input string) {
+		_, _, err := isodates.ParseMonthDayLenient(input)
+		suite.Error(err)
+	}
+
+	fails("")
+	fails("not valid")
+	fails("--00-01")
+	fails("--01-00")
+	fails("--1--2")
+
+	succeeds("--1-1", time.January, 1)
+	succeeds("--05-30", time.May, 30)
 
 	// Roll over to subsequent months and we assume leap years
+	succeeds("--01-32", time.February, 1)
 	succeeds("--05-32", time.June, 1)
 	succeeds("--05-65", time.July, 4)
 	succeeds("--02-28", time.February, 28)
@@ -82,6 +123,7 @@ func (suite *MonthDaySuite) TestParseMonthDayStart() {
 
 	fails("")
 	fails("not valid")
+	fails("--02-30")
 
 	succeeds("--01-01", 2000, 2000, time.January, 1)
 	succeeds("--01-01", 2019, 2019, time.January, 1)
@@ -90,6 +132,10 @@ func (suite *MonthDaySuite) TestParseMonthDayStart() {
 	succeeds("--05-30", 123, 123, time.May, 30)
 	succeeds("--05-30", 23, 23, time.May, 30)
 	succeeds("--05-30", 1, 1, time.May, 30)
+
+	// Leap days move to March 1st in non-leap years
+	succeeds("--02-29", 2020, 2020, time.February, 29)
+	succeeds("--02-29", 2019, 2019, time.March, 1)
 }
 
 func (suite *MonthDaySuite) TestParseMonthDayStartIn() {
@@ -135,6 +181,7 @@ func (suite *MonthDaySuite) TestParseMonthDayEnd() {
 
 	fails("")
 	fails("not valid")
+	fails("--02-30")
 
 	succeeds("--01-01", 2000, 2000, time.January, 1)
 	succeeds("--01-01", 2019, 2019, time.January, 1)
@@ -143,6 +190,10 @@ func (suite *MonthDaySuite) TestParseMonthDayEnd() {
 	succeeds("--05-30", 123, 123, time.May, 30)
 	succeeds("--05-30", 23, 23, time.May, 30)
 	succeeds("--05-30", 1, 1, time.May, 30)
+
+	// Leap days move to March 1st in non-leap years
+	succeeds("--02-29", 2020, 2020, time.February, 29)
+	succeeds("--02-29", 2019, 2019, time.March, 1)
 }
 
 func (suite *MonthDaySuite) TestParseMonthDayEndIn() {
@@ -176,16 +227,123 @@ func (suite *MonthDaySuite) TestParseMonthDayEndIn() {
 	succeeds("--05-30", 1, 1, time.May, 30, locationPDT)
 }
 
+func (suite *MonthDaySuite) TestNextMonthDayOccurrence() {
+	succeeds := func(input string, ref time.Time, loc *time.Location, year int, month time.Month, day int) {
+		start, end, err := isodates.NextMonthDayOccurrence(input, ref, loc)
+		_ = suite.AssertMidnightIn(start, err, year, month, day, loc) &&
+			suite.AssertAlmostMidnightIn(end, err, year, month, day, loc)
+	}
+	fails := func(input string, ref time.Time, loc *time.Location) {
+		_, _, err := isodates.NextMonthDayOccurrence(input, ref, loc)
+		suite.Error(err)
+	}
+	ref := time.Date(2019, time.June, 15, 12, 0, 0, 0, time.UTC)
+	fails("", ref, time.UTC)
+	fails("--02-30", ref, time.UTC)
+	fails("--12-25", ref, nil)
+
+	succeeds("--12-25", ref, time.UTC, 2019, time.December, 25)
+	succeeds("--06-16", ref, time.UTC, 2019, time.June, 16)
+	succeeds("--06-15", ref, time.UTC, 2019, time.June, 15) // today counts
+	succeeds("--06-14", ref, time.UTC, 2020, time.June, 14)
+	succeeds("--01-01", ref, locationEDT, 2020, time.January, 1)
+
+	// "Today" depends on the time zone. It's 10pm on the 14th in LA, but 1am on the 15th in NY.
+	ref = time.Date(2019, time.June, 15, 5, 0, 0, 0, time.UTC)
+	succeeds("--06-14", ref, locationPDT, 2019, time.June, 14)
+	succeeds("--06-14", ref, locationEDT, 2020, time.June, 14)
+
+	// Leap days roll to March 1st in non-leap years
+	succeeds("--02-29", ref, time.UTC, 2020, time.February, 29)
+	succeeds("--02-29", time.Date(2020, time.March, 1, 0, 0, 0, 0, time.UTC), time.UTC, 2021, time.March, 1)
+}
+
+func (suite *MonthDaySuite) TestPreviousMonthDayOccurrence() {
+	succeeds := func(input string, ref time.Time, loc *time.Location, year int, month time.Month, day int) {
+		start, end, err := isodates.PreviousMonthDayOccurrence(input, ref, loc)
+		_ = suite.AssertMidnightIn(start, err, year, month, day, loc) &&
+			suite.AssertAlmostMidnightIn(end, err, year, month, day, loc)
+	}
+	fails := func(input string, ref time.Time, loc *time.Location) {
+		_, _, err := isodates.PreviousMonthDayOccurrence(input, ref, loc)
+		suite.Error(err)
+	}
+	ref := time.Date(2019, time.June, 15, 12, 0, 0, 0, time.UTC)
+	fails("", ref, time.UTC)
+	fails("--02-30", ref, time.UTC)
+	fails("--12-25", ref, nil)
+
+	succeeds("--12-25", ref, time.UTC, 2018, time.December, 25)
+	succeeds("--06-14", ref, time.UTC, 2019, time.June, 14)
+	succeeds("--06-15", ref, time.UTC, 2019, time.June, 15) // today counts
+	succeeds("--06-16", ref, time.UTC, 2018, time.June, 16)
+
+	// "Today" depends on the time zone. It's 10pm on the 14th in LA, but 1am on the 15th in NY.
+	ref = time.Date(2019, time.June, 15, 5, 0, 0, 0, time.UTC)
+	succeeds("--06-15", ref, locationPDT, 2018, time.June, 15)
+	succeeds("--06-15", ref, locationEDT, 2019, time.June, 15)
+
+	// Leap days roll to March 1st in non-leap years
+	succeeds("--02-29", ref, time.UTC, 2019, time.March, 1)
+}
+
+func (suite *MonthDaySuite) TestParseMonthDayEndExclusiveIn() {
+	succeeds := func(input string, inputYear int, loc *time.Location, year int, month time.Month, day int) {
+		date, err := isodates.ParseMonthDayEndExclusiveIn(input, inputYear, loc)
+		suite.AssertMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(input string, year int, loc *time.Location) {
+		_, err := isodates.ParseMonthDayEndExclusiveIn(input, year, loc)
+		suite.Error(err)
+	}
+	fails("", 2019, time.UTC)
+	fails("--02-30", 2019, time.UTC)
+	fails("--12-25", 2019, nil)
+
+	succeeds("--12-25", 2019, time.UTC, 2019, time.December, 26)
+	succeeds("--12-31", 2019, locationEDT, 2020, time.January, 1)
+	succeeds("--02-29", 2019, locationPDT, 2019, time.March, 2)
+
+	date, err := isodates.ParseMonthDayEndExclusive("--02-28", 2020)
+	suite.AssertMidnightUTC(date, err, 2020, time.February, 29)
+}
+
+func (suite *MonthDaySuite) TestParseMonthDayRangeIn() {
+	succeeds := func(input string, inputYear int, loc *time.Location, year int, month time.Month, day int) {
+		r, err := isodates.ParseMonthDayRangeIn(input, inputYear, loc)
+		suite.AssertRangeIn(r, err, year, month, day, year, month, day, loc)
+	}
+	fails := func(input string, inputYear int, loc *time.Location) {
+		_, err := isodates.ParseMonthDayRangeIn(input, inputYear, loc)
+		suite.Error(err)
+	}
+	fails("", 2019, time.UTC)
+	fails("--02-30", 2019, time.UTC)
+	fails("--12-25", 2019, nil)
+
+	succeeds("--12-25", 2019, time.UTC, 2019, time.December, 25)
+	succeeds("--12-25", 2019, locationEDT, 2019, time.December, 25)
+	succeeds("--02-29", 2019, locationPDT, 2019, time.March, 1)
+
+	r, err := isodates.ParseMonthDayRange("--12-25", 2019)
+	suite.AssertRangeIn(r, err, 2019, time.December, 25, 2019, time.December, 25, time.UTC)
+}
+
 func ExampleParseMonthDay() {
 	// Standard usage
 	month, day, err := isodates.ParseMonthDay("--04-01")
 	fmt.Println(fmt.Sprintf("%d %d %v", month, day, err == nil))
 
-	// Automatic rollover to subsequent months
+	// Days that never exist in that month are rejected
 	month, day, err = isodates.ParseMonthDay("--01-34")
 	fmt.Println(fmt.Sprintf("%d %d %v", month, day, err == nil))
 
+	// ...unless you explicitly ask for them to roll over to subsequent months
+	month, day, err = isodates.ParseMonthDayLenient("--01-34")
+	fmt.Println(fmt.Sprintf("%d %d %v", month, day, err == nil))
+
 	// Output: 4 1 true
+	// 0 0 false
 	// 2 3 true
 }
 
@@ -0,0 +1,108 @@
+package isodates
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParseOrdinalDate accepts an ISO-formatted ordinal date string (e.g. "2019-142") and returns the year
+// and day of the year (1-366) that it represents. Day 366 is only valid in leap years. It also accepts
+// the basic format (e.g. "2019142") and expanded years with a sign (e.g. "+10000-001").
+func ParseOrdinalDate(input string) (year int, dayOfYear int, err error) {
+	layout := layoutOf(input, "YYYY-DDD", "YYYYDDD")
+	if err = layout.checkLength(input); err != nil {
+		return 0, 0, err
+	}
+	if err = checkLiteral(layout.format, input, layout.yearEnd, layout.separator()); err != nil {
+		return 0, 0, err
+	}
+
+	year, err = layout.parseYear(input)
+	if err != nil {
+		return 0, 0, err
+	}
+	dayStart := len(input) - 3
+	dayOfYear, err = parseDayOfYear(layout.format, input, dayStart, len(input))
+	if err != nil {
+		return 0, 0, err
+	}
+	if daysInYear := daysInYear(year); dayOfYear > daysInYear {
+		detail := fmt.Sprintf("%d has %d days", year, daysInYear)
+		return 0, 0, nonexistentError(layout.format, input, ComponentDayOfYear, dayStart, len(input), detail)
+	}
+	return year, dayOfYear, nil
+}
+
+// ParseOrdinalDateStart accepts an ISO-formatted ordinal date string (e.g. "2019-142") and returns the
+// given date set to exactly midnight in UTC.
+func ParseOrdinalDateStart(input string) (time.Time, error) {
+	return ParseOrdinalDateStartIn(input, time.UTC)
+}
+
+// ParseOrdinalDateStartIn accepts an ISO-formatted ordinal date string (e.g. "2019-142") and returns the
+// given date set to exactly midnight in the specified location.
+func ParseOrdinalDateStartIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse ordinal date start: %w", ErrNilLocation)
+	}
+	year, dayOfYear, err := ParseOrdinalDate(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	return Midnight(year, time.January, dayOfYear, loc), nil
+}
+
+// ParseOrdinalDateEnd accepts an ISO-formatted ordinal date string (e.g. "2019-142") and returns the
+// given date set to 11:59:59pm (one EndPrecision before midnight) in UTC.
+func ParseOrdinalDateEnd(input string) (time.Time, error) {
+	return ParseOrdinalDateEndIn(input, time.UTC)
+}
+
+// ParseOrdinalDateEndIn accepts an ISO-formatted ordinal date string (e.g. "2019-142") and returns the
+// given date set to 11:59:59pm (one EndPrecision before midnight) in the specified location.
+func ParseOrdinalDateEndIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse ordinal date end: %w", ErrNilLocation)
+	}
+	year, dayOfYear, err := ParseOrdinalDate(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	return AlmostMidnight(year, time.January, dayOfYear, loc), nil
+}
+
+// ParseOrdinalDateEndExclusive accepts an ISO-formatted ordinal date string (e.g. "2019-142") and
+// returns midnight at the start of the following day in UTC.
+func ParseOrdinalDateEndExclusive(input string) (time.Time, error) {
+	return ParseOrdinalDateEndExclusiveIn(input, time.UTC)
+}
+
+// ParseOrdinalDateEndExclusiveIn accepts an ISO-formatted ordinal date string (e.g. "2019-142") and
+// returns midnight at the start of the following day in the specified location.
+func ParseOrdinalDateEndExclusiveIn(input string, loc *time.Location) (time.Time, error) {
+	return exclusiveEnd(ParseOrdinalDateEndIn(input, loc))
+}
+
+// ParseOrdinalDateRange accepts an ISO-formatted ordinal date string (e.g. "2019-142") and returns the
+// range from midnight through 11:59:59pm on that date in UTC.
+func ParseOrdinalDateRange(input string) (Range, error) {
+	return ParseOrdinalDateRangeIn(input, time.UTC)
+}
+
+// ParseOrdinalDateRangeIn accepts an ISO-formatted ordinal date string (e.g. "2019-142") and returns the
+// range from midnight through 11:59:59pm on that date in the specified location.
+func ParseOrdinalDateRangeIn(input string, loc *time.Location) (Range, error) {
+	if loc == nil {
+		return Range{}, fmt.Errorf("parse ordinal date range: %w", ErrNilLocation)
+	}
+	year, dayOfYear, err := ParseOrdinalDate(input)
+	if err != nil {
+		return Range{}, err
+	}
+	return Range{Start: Midnight(year, time.January, dayOfYear, loc), End: AlmostMidnight(year, time.January, dayOfYear, loc)}, nil
+}
+
+// daysInYear returns 366 for leap years and 365 for all others.
+func daysInYear(year int) int {
+	return 337 + DaysInMonth(year, time.February)
+}
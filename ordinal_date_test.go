@@ -0,0 +1,132 @@
+package isodates_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestOrdinalDateSuite(t *testing.T) {
+	suite.Run(t, new(OrdinalDateSuite))
+}
+
+type OrdinalDateSuite struct {
+	ChronoSuite
+}
+
+func (suite *OrdinalDateSuite) TestParseOrdinalDate() {
+	succeeds := func(input string, year int, dayOfYear int) {
+		actualYear, actualDay, err := isodates.ParseOrdinalDate(input)
+		_ = suite.NoError(err) &&
+			suite.Equal(year, actualYear, "incorrect year") &&
+			suite.Equal(dayOfYear, actualDay, "incorrect day of year")
+	}
+	fails := func(input string, reason isodates.Reason) {
+		_, _, err := isodates.ParseOrdinalDate(input)
+		var parseErr *isodates.ParseError
+		_ = suite.True(errors.As(err, &parseErr), "%v", err) &&
+			suite.Equal(string(reason), string(parseErr.Reason), input)
+	}
+	fails("", isodates.ReasonInvalidFormat)
+	fails("2019-05-22", isodates.ReasonInvalidFormat)
+	fails("2019-W05", isodates.ReasonNotNumeric)
+	fails("201914", isodates.ReasonInvalidFormat)
+	fails("2019/142", isodates.ReasonInvalidFormat)
+	fails("2019-14", isodates.ReasonInvalidFormat)
+	fails("XXXX-142", isodates.ReasonNotNumeric)
+	fails("2019-1X2", isodates.ReasonNotNumeric)
+	fails("2019-000", isodates.ReasonOutOfRange)
+	fails("2019-367", isodates.ReasonOutOfRange)
+	fails("2019-366", isodates.ReasonNonexistent)
+	fails("2100-366", isodates.ReasonNonexistent)
+	fails("2019366", isodates.ReasonNonexistent)
+	fails("+201-142", isodates.ReasonInvalidFormat) // expanded years need at least 4 digits
+	fails("+-019-142", isodates.ReasonNotNumeric)
+	fails("2019-+42", isodates.ReasonNotNumeric)
+
+	succeeds("2019-001", 2019, 1)
+	succeeds("2019-142", 2019, 142)
+	succeeds("2019-365", 2019, 365)
+	succeeds("2020-366", 2020, 366)
+	succeeds("2000-366", 2000, 366)
+	succeeds("2019142", 2019, 142)
+	succeeds("+002019-142", 2019, 142)
+	succeeds("+10000-001", 10000, 1)
+	succeeds("+10000001", 10000, 1)
+	succeeds("-0044-074", -44, 74)
+	succeeds("-0044074", -44, 74)
+
+	_, _, err := isodates.ParseOrdinalDate("2019-366")
+	suite.EqualError(err, "invalid day of year: 366 (2019 has 365 days)")
+}
+
+func (suite *OrdinalDateSuite) TestParseOrdinalDateStartIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := isodates.ParseOrdinalDateStartIn(input, loc)
+		suite.AssertMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.ParseOrdinalDateStartIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", time.UTC)
+	fails("2019-366", time.UTC)
+	fails("2019-142", nil)
+
+	succeeds("2019-001", time.UTC, 2019, time.January, 1)
+	succeeds("2019-142", locationEDT, 2019, time.May, 22)
+	succeeds("2020-060", locationPDT, 2020, time.February, 29)
+	succeeds("2019-060", locationPDT, 2019, time.March, 1)
+	succeeds("2020-366", time.UTC, 2020, time.December, 31)
+
+	date, err := isodates.ParseOrdinalDateStart("2019-142")
+	suite.AssertMidnightUTC(date, err, 2019, time.May, 22)
+}
+
+func (suite *OrdinalDateSuite) TestParseOrdinalDateEndIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := isodates.ParseOrdinalDateEndIn(input, loc)
+		suite.AssertAlmostMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.ParseOrdinalDateEndIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", time.UTC)
+	fails("2019-366", time.UTC)
+	fails("2019-142", nil)
+
+	succeeds("2019-001", time.UTC, 2019, time.January, 1)
+	succeeds("2019-142", locationEDT, 2019, time.May, 22)
+	succeeds("2019-365", locationPDT, 2019, time.December, 31)
+
+	date, err := isodates.ParseOrdinalDateEnd("2019-142")
+	suite.AssertAlmostMidnightUTC(date, err, 2019, time.May, 22)
+}
+
+func (suite *OrdinalDateSuite) TestParseOrdinalDateEndExclusiveIn() {
+	date, err := isodates.ParseOrdinalDateEndExclusiveIn("2019-365", locationEDT)
+	suite.AssertMidnightIn(date, err, 2020, time.January, 1, locationEDT)
+
+	date, err = isodates.ParseOrdinalDateEndExclusive("2019-142")
+	suite.AssertMidnightUTC(date, err, 2019, time.May, 23)
+
+	_, err = isodates.ParseOrdinalDateEndExclusiveIn("2019-142", nil)
+	suite.Error(err)
+}
+
+func (suite *OrdinalDateSuite) TestParseOrdinalDateRangeIn() {
+	r, err := isodates.ParseOrdinalDateRangeIn("2019-142", locationEDT)
+	suite.AssertRangeIn(r, err, 2019, time.May, 22, 2019, time.May, 22, locationEDT)
+
+	r, err = isodates.ParseOrdinalDateRange("2020-366")
+	suite.AssertRangeIn(r, err, 2020, time.December, 31, 2020, time.December, 31, time.UTC)
+
+	_, err = isodates.ParseOrdinalDateRangeIn("2019-366", time.UTC)
+	suite.Error(err)
+	_, err = isodates.ParseOrdinalDateRangeIn("2019-142", nil)
+	suite.Error(err)
+}
@@ -0,0 +1,228 @@
+package isodates
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// anyFormat is the format we report when Parse() can't even tell which kind of value the input is.
+const anyFormat = "YYYY-MM-DD, YYYY-MM-DDThh:mm:ssZ, YYYY-W##, YYYY-W##-#, YYYY-MM, or --MM-DD"
+
+// Kind identifies which of the supported ISO formats a Value was parsed from.
+type Kind int
+
+const (
+	// KindDate is a year-month-day such as "2019-05-22".
+	KindDate Kind = iota + 1
+	// KindDateTime is an RFC 3339 date/time such as "2019-05-22T12:33:53Z".
+	KindDateTime
+	// KindWeek is a year/week such as "2019-W05".
+	KindWeek
+	// KindWeekDay is a year/week/day such as "2019-W05-3".
+	KindWeekDay
+	// KindYearMonth is a year/month such as "2019-05" (or "+2019-05").
+	KindYearMonth
+	// KindMonthDay is a month/day with no year such as "--05-22".
+	KindMonthDay
+)
+
+// String returns the name of the kind (e.g. "week" or "year-month").
+func (kind Kind) String() string {
+	switch kind {
+	case KindDate:
+		return "date"
+	case KindDateTime:
+		return "date-time"
+	case KindWeek:
+		return "week"
+	case KindWeekDay:
+		return "week-day"
+	case KindYearMonth:
+		return "year-month"
+	case KindMonthDay:
+		return "month-day"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(kind))
+	}
+}
+
+// Value is the result of Parse(). It tells you which kind of input you were given along with the
+// components that it contained. Components that the kind doesn't have are left as zero values (e.g.
+// a KindWeek value has no Month or Day).
+type Value struct {
+	// Kind is the format that the input matched.
+	Kind Kind
+	// Year is the year (or ISO week-numbering year for weeks). Month/day values have no year.
+	Year int
+	// Month is the month for dates, year/months, and month/days.
+	Month time.Month
+	// Day is the day of the month for dates and month/days.
+	Day int
+	// Week is the ISO week number for weeks and week/days.
+	Week int
+	// WeekDay is the day of the week (1 = Monday through 7 = Sunday) for week/days.
+	WeekDay int
+	// DateTime is the exact instant for date/times.
+	DateTime time.Time
+	// YearUnspecified is true when the input's year was "XXXX" (e.g. "XXXX-12-25"). Year is zero, and
+	// you need a YearRule to decide which year was meant before you can get the value's range.
+	YearUnspecified bool
+}
+
+// Parse figures out which of the supported ISO formats the input is (date, date/time, week, week/day,
+// year/month, or month/day) and parses it. It looks at the shape of the input to decide, so it only
+// ever runs the one parser that applies. Weeks follow ISO 8601 rules. Like the individual parsers, it
+// accepts the basic format (e.g. "20190522" or "2019W053") and expanded years (e.g. "+10000-01-01"). Dates, weeks, week/days, and
+// year/months can have an unspecified year (e.g. "XXXX-12-25"); see Value.YearUnspecified.
+func Parse(input string) (Value, error) {
+	if HasUnspecifiedYear(input) {
+		return parseUnspecifiedYear(input)
+	}
+	kind, ok := detectKind(input)
+	if !ok {
+		return Value{}, formatError(anyFormat, input, 0)
+	}
+
+	value := Value{Kind: kind}
+	var err error
+	switch kind {
+	case KindDate:
+		value.Year, value.Month, value.Day, err = ParseDate(input)
+	case KindDateTime:
+		value.DateTime, err = ParseDateTime(input)
+		value.Year, value.Month, value.Day = value.DateTime.Date()
+	case KindWeek:
+		value.Year, value.Week, err = ParseWeek(input)
+	case KindWeekDay:
+		value.Year, value.Week, value.WeekDay, err = ParseWeekDay(input)
+	case KindYearMonth:
+		value.Year, value.Month, err = ParseYearMonth(input)
+	case KindMonthDay:
+		value.Month, value.Day, err = ParseMonthDay(input)
+	}
+	if err != nil {
+		return Value{}, err
+	}
+	return value, nil
+}
+
+// Start returns the first instant of the value in the given time zone: midnight on the first day for
+// dates, weeks, week/days, and year/months, or the exact instant for date/times. Month/day values don't
+// have a year, so they result in an error; use ParseMonthDayStartIn() for those. The same goes for
+// values with an unspecified year; use YearRule.ResolveRange() for those.
+func (value Value) Start(loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("value start: %w", ErrNilLocation)
+	}
+	switch {
+	case value.Kind == KindDateTime:
+		return value.DateTime.In(loc), nil
+	case value.Kind == KindMonthDay:
+		return ZeroTime, errors.New("value start: month/day has no year")
+	case value.YearUnspecified:
+		return ZeroTime, errors.New("value start: year is unspecified")
+	}
+	year, month, day := value.firstDay()
+	return Midnight(year, month, day, loc), nil
+}
+
+// End returns the last instant of the value in the given time zone: 11:59:59pm on the last day for
+// dates, weeks, week/days, and year/months, or the exact instant for date/times. Month/day values don't
+// have a year, so they result in an error; use ParseMonthDayEndIn() for those. The same goes for
+// values with an unspecified year; use YearRule.ResolveRange() for those.
+func (value Value) End(loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("value end: %w", ErrNilLocation)
+	}
+	switch {
+	case value.Kind == KindDateTime:
+		return value.DateTime.In(loc), nil
+	case value.Kind == KindMonthDay:
+		return ZeroTime, errors.New("value end: month/day has no year")
+	case value.YearUnspecified:
+		return ZeroTime, errors.New("value end: year is unspecified")
+	}
+	year, month, day := value.firstDay()
+	switch value.Kind {
+	case KindWeek:
+		return AlmostMidnight(year, month, day+6, loc), nil
+	case KindYearMonth:
+		return AlmostMidnight(year, month+1, 0, loc), nil
+	default:
+		return AlmostMidnight(year, month, day, loc), nil
+	}
+}
+
+// Range returns both the Start() and End() of the value in the given time zone.
+func (value Value) Range(loc *time.Location) (Range, error) {
+	start, err := value.Start(loc)
+	if err != nil {
+		return Range{}, err
+	}
+	end, err := value.End(loc)
+	if err != nil {
+		return Range{}, err
+	}
+	return Range{Start: start, End: end}, nil
+}
+
+// firstDay returns the calendar date of the first day covered by the value.
+func (value Value) firstDay() (year int, month time.Month, day int) {
+	switch value.Kind {
+	case KindWeek:
+		return ISOWeeks.StartDate(value.Year, value.Week)
+	case KindWeekDay:
+		year, month, day = ISOWeeks.StartDate(value.Year, value.Week)
+		return year, month, day + value.WeekDay - 1
+	case KindYearMonth:
+		return value.Year, value.Month, 1
+	default:
+		return value.Year, value.Month, value.Day
+	}
+}
+
+// detectKind looks at the separators and length of the input to figure out which kind of value it
+// should be. It understands the basic forms (e.g. "20190522" or "2019W053") and expanded years (e.g.
+// "+10000-01-01") just like the parsers do, but it doesn't validate any of the components; that's up
+// to the actual parser.
+func detectKind(input string) (Kind, bool) {
+	switch {
+	case strings.HasPrefix(input, "--"):
+		return KindMonthDay, true
+	case strings.ContainsRune(input, 'T'):
+		return KindDateTime, true
+	}
+
+	// Weeks and week/days only differ by the day after the week number (e.g. "-3" in "2019-W05-3" or
+	// "3" in "2019W053"), so anything longer than the 2 digit week must be a week/day.
+	if week := strings.IndexByte(input, 'W'); week >= 0 {
+		if len(input)-week-1 > 2 {
+			return KindWeekDay, true
+		}
+		return KindWeek, true
+	}
+
+	// Everything after the year has a fixed length, so count back from the end to find the separators.
+	length := len(input)
+	if length < 7 {
+		return 0, false
+	}
+	signed := input[0] == '+' || input[0] == '-'
+	switch {
+	case length >= 10 && input[length-3] == '-' && input[length-6] == '-':
+		return KindDate, true
+	case input[length-3] == '-':
+		return KindYearMonth, true
+	case signed && length >= 9 && isDigits(input[1:]):
+		return KindDate, true
+	case !signed && length == 8 && isDigits(input):
+		return KindDate, true
+	case length == 10:
+		return KindDate, true
+	case length == 7:
+		return KindYearMonth, true
+	}
+	return 0, false
+}
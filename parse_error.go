@@ -0,0 +1,156 @@
+package isodates
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNilLocation is returned (wrapped with the name of the operation) by any of the "In" functions
+// when you pass a nil *time.Location. Use errors.Is(err, ErrNilLocation) to detect it.
+var ErrNilLocation = errors.New("nil location")
+
+// Component identifies the part of an input string that failed to parse.
+type Component string
+
+const (
+	// ComponentYear is the year portion of the input (e.g. "2019" in "2019-W05").
+	ComponentYear = Component("year")
+	// ComponentMonth is the month portion of the input (e.g. "05" in "2019-05-22").
+	ComponentMonth = Component("month")
+	// ComponentWeek is the week number portion of the input (e.g. "05" in "2019-W05").
+	ComponentWeek = Component("week")
+	// ComponentDay is the day of month portion of the input (e.g. "22" in "2019-05-22").
+	ComponentDay = Component("day")
+	// ComponentDayOfYear is the day of year portion of an ordinal date input (e.g. "142" in "2019-142").
+	ComponentDayOfYear = Component("day_of_year")
+	// ComponentOffset is the day of the week portion of a week/day input (e.g. "3" in "2019-W05-3").
+	ComponentOffset = Component("offset")
+	// ComponentQuarter is the quarter portion of a fiscal quarter input (e.g. "1" in "FY2020-Q1").
+	ComponentQuarter = Component("quarter")
+	// ComponentPeriod is the period portion of a retail period input (e.g. "03" in "2019-P03").
+	ComponentPeriod = Component("period")
+	// ComponentTimeZone is the hours or minutes of an XML Schema time zone suffix (e.g. "02" in "2019-05+02:00").
+	ComponentTimeZone = Component("time_zone")
+)
+
+// Reason is a machine-readable code describing why an input failed to parse.
+type Reason string
+
+const (
+	// ReasonInvalidFormat means that the input doesn't have the expected length or separators.
+	ReasonInvalidFormat = Reason("invalid_format")
+	// ReasonNotNumeric means that a component of the input is not a number.
+	ReasonNotNumeric = Reason("not_numeric")
+	// ReasonOutOfRange means that a component is a number, but not one that is ever valid (e.g. month 13).
+	ReasonOutOfRange = Reason("out_of_range")
+	// ReasonNonexistent means that a component is normally valid, but not in this particular month/year
+	// (e.g. "2019-02-29" or "2019-W53").
+	ReasonNonexistent = Reason("nonexistent")
+)
+
+// ParseError describes why an input string could not be parsed. All of the ParseXyz functions return
+// a *ParseError when the input itself is bad, so you can use errors.As() to report exactly which part
+// of the input was wrong rather than string-matching error messages.
+type ParseError struct {
+	// Format is the format that the input was expected to have (e.g. "YYYY-W##").
+	Format string
+	// Input is the full input string that we tried to parse.
+	Input string
+	// Component is the part of the input that failed. It's empty when the input as a whole doesn't
+	// match the format (i.e. the reason is ReasonInvalidFormat).
+	Component Component
+	// Value is the text of the failing component (e.g. "13" for month 13). It's empty when the input
+	// as a whole doesn't match the format.
+	Value string
+	// Offset is the byte offset in the input where the problem starts. For format errors, this is the
+	// first character that doesn't match the format (or the length of the input if it's too short).
+	Offset int
+	// Reason is a machine-readable code describing what was wrong.
+	Reason Reason
+
+	// detail is extra human-readable context about why the value doesn't exist (e.g. "2019 has 52 weeks").
+	detail string
+	// cause is the underlying error from the standard library, if there was one.
+	cause error
+}
+
+// Error returns a human-readable description of the problem (e.g. "invalid month: 13").
+func (err *ParseError) Error() string {
+	if err.Component == "" {
+		return fmt.Sprintf("invalid %s format: %s", err.Format, err.Input)
+	}
+	message := fmt.Sprintf("invalid %s: %s", err.Component.label(), err.Value)
+	if err.detail != "" {
+		message += " (" + err.detail + ")"
+	}
+	return message
+}
+
+// Unwrap returns the underlying standard library error (e.g. a *time.ParseError), if there is one.
+func (err *ParseError) Unwrap() error {
+	return err.cause
+}
+
+// label is the name we use for the component in error messages.
+func (component Component) label() string {
+	switch component {
+	case ComponentWeek:
+		return "week number"
+	case ComponentDay:
+		return "day of month"
+	case ComponentDayOfYear:
+		return "day of year"
+	case ComponentOffset:
+		return "week offset"
+	case ComponentTimeZone:
+		return "time zone"
+	default:
+		return string(component)
+	}
+}
+
+// formatError indicates that the input doesn't match the format, starting at the given byte offset.
+func formatError(format string, input string, offset int) *ParseError {
+	return &ParseError{Format: format, Input: input, Offset: offset, Reason: ReasonInvalidFormat}
+}
+
+// componentError indicates that the component found at input[start:end] is bad for the given reason.
+func componentError(format string, input string, component Component, start int, end int, reason Reason) *ParseError {
+	return &ParseError{
+		Format:    format,
+		Input:     input,
+		Component: component,
+		Value:     input[start:end],
+		Offset:    start,
+		Reason:    reason,
+	}
+}
+
+// nonexistentError indicates that the component found at input[start:end] doesn't exist in the given
+// month/year, with some extra detail explaining why (e.g. "2019 has 52 weeks").
+func nonexistentError(format string, input string, component Component, start int, end int, detail string) *ParseError {
+	err := componentError(format, input, component, start, end, ReasonNonexistent)
+	err.detail = detail
+	return err
+}
+
+// checkLength makes sure that the input is exactly the given number of bytes long.
+func checkLength(format string, input string, length int) error {
+	switch {
+	case len(input) < length:
+		return formatError(format, input, len(input))
+	case len(input) > length:
+		return formatError(format, input, length)
+	}
+	return nil
+}
+
+// checkLiteral makes sure that the input contains the literal text (e.g. "-W") at the given offset.
+func checkLiteral(format string, input string, offset int, literal string) error {
+	for i := 0; i < len(literal); i++ {
+		if offset+i >= len(input) || input[offset+i] != literal[i] {
+			return formatError(format, input, offset+i)
+		}
+	}
+	return nil
+}
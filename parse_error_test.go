@@ -0,0 +1,165 @@
+package isodates_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestParseErrorSuite(t *testing.T) {
+	suite.Run(t, new(ParseErrorSuite))
+}
+
+type ParseErrorSuite struct {
+	ChronoSuite
+}
+
+// assertParseError makes sure that the error is a *ParseError with the given details.
+func (suite *ParseErrorSuite) assertParseError(err error, format string, input string, component isodates.Component, value string, offset int, reason isodates.Reason) bool {
+	var parseErr *isodates.ParseError
+	return suite.Error(err) &&
+		suite.True(errors.As(err, &parseErr), "expected a *ParseError: %v", err) &&
+		suite.Equal(format, parseErr.Format) &&
+		suite.Equal(input, parseErr.Input) &&
+		suite.Equal(component, parseErr.Component) &&
+		suite.Equal(value, parseErr.Value) &&
+		suite.Equal(offset, parseErr.Offset) &&
+		suite.Equal(reason, parseErr.Reason)
+}
+
+func (suite *ParseErrorSuite) TestDate() {
+	fails := func(input string, component isodates.Component, value string, offset int, reason isodates.Reason) {
+		_, _, _, err := isodates.ParseDate(input)
+		suite.assertParseError(err, "YYYY-MM-DD", input, component, value, offset, reason)
+	}
+	fails("", "", "", 0, isodates.ReasonInvalidFormat)
+	fails("2019-05", "", "", 7, isodates.ReasonInvalidFormat)
+	fails("2019-05-222", "", "", 10, isodates.ReasonInvalidFormat)
+	fails("2019/05/22", "", "", 4, isodates.ReasonInvalidFormat)
+	fails("2019-05/22", "", "", 7, isodates.ReasonInvalidFormat)
+	fails("20x9-05-22", isodates.ComponentYear, "20x9", 0, isodates.ReasonNotNumeric)
+	fails("2019-xx-22", isodates.ComponentMonth, "xx", 5, isodates.ReasonNotNumeric)
+	fails("2019-13-22", isodates.ComponentMonth, "13", 5, isodates.ReasonOutOfRange)
+	fails("2019-05-x2", isodates.ComponentDay, "x2", 8, isodates.ReasonNotNumeric)
+	fails("2019-05-00", isodates.ComponentDay, "00", 8, isodates.ReasonOutOfRange)
+	fails("2019-02-29", isodates.ComponentDay, "29", 8, isodates.ReasonNonexistent)
+}
+
+func (suite *ParseErrorSuite) TestWeek() {
+	fails := func(input string, component isodates.Component, value string, offset int, reason isodates.Reason) {
+		_, _, err := isodates.ParseWeek(input)
+		suite.assertParseError(err, "YYYY-W##", input, component, value, offset, reason)
+	}
+	fails("2019-W5", "", "", 7, isodates.ReasonInvalidFormat)
+	fails("2019-w05", "", "", 5, isodates.ReasonInvalidFormat)
+	fails("2019W-05", "", "", 4, isodates.ReasonInvalidFormat)
+	fails("2019-W00", isodates.ComponentWeek, "00", 6, isodates.ReasonOutOfRange)
+	fails("2019-W54", isodates.ComponentWeek, "54", 6, isodates.ReasonOutOfRange)
+	fails("2019-W53", isodates.ComponentWeek, "53", 6, isodates.ReasonNonexistent)
+}
+
+func (suite *ParseErrorSuite) TestWeekDay() {
+	fails := func(input string, component isodates.Component, value string, offset int, reason isodates.Reason) {
+		_, _, _, err := isodates.ParseWeekDay(input)
+		suite.assertParseError(err, "YYYY-W##-#", input, component, value, offset, reason)
+	}
+	fails("2019-W05", "", "", 8, isodates.ReasonInvalidFormat)
+	fails("2019-W05/1", "", "", 8, isodates.ReasonInvalidFormat)
+	fails("x019-W05-1", isodates.ComponentYear, "x019", 0, isodates.ReasonNotNumeric)
+	fails("2019-W05-8", isodates.ComponentOffset, "8", 9, isodates.ReasonOutOfRange)
+	fails("2019-W53-1", isodates.ComponentWeek, "53", 6, isodates.ReasonNonexistent)
+}
+
+func (suite *ParseErrorSuite) TestYearMonth() {
+	fails := func(input string, format string, component isodates.Component, value string, offset int, reason isodates.Reason) {
+		_, _, err := isodates.ParseYearMonth(input)
+		suite.assertParseError(err, format, input, component, value, offset, reason)
+	}
+	fails("2019", "[+-]YYYY-MM", "", "", 4, isodates.ReasonInvalidFormat)
+	fails("2019/05", "YYYY-MM", "", "", 4, isodates.ReasonInvalidFormat)
+	fails("*2019-05", "[+-]YYYY-MM", "", "", 0, isodates.ReasonInvalidFormat)
+	fails("2019-13", "YYYY-MM", isodates.ComponentMonth, "13", 5, isodates.ReasonOutOfRange)
+	fails("+2019-13", "[+-]YYYY-MM", isodates.ComponentMonth, "13", 6, isodates.ReasonOutOfRange)
+	fails("-20x9-05", "[+-]YYYY-MM", isodates.ComponentYear, "-20x9", 0, isodates.ReasonNotNumeric)
+}
+
+func (suite *ParseErrorSuite) TestMonthDay() {
+	fails := func(input string, component isodates.Component, value string, offset int, reason isodates.Reason) {
+		_, _, err := isodates.ParseMonthDay(input)
+		suite.assertParseError(err, "--MM-DD", input, component, value, offset, reason)
+	}
+	fails("--1", "", "", 3, isodates.ReasonInvalidFormat)
+	fails("--12/25", "", "", 4, isodates.ReasonInvalidFormat)
+	fails("--13-25", isodates.ComponentMonth, "13", 2, isodates.ReasonOutOfRange)
+	fails("--2-x", isodates.ComponentDay, "x", 4, isodates.ReasonNotNumeric)
+	fails("--02-30", isodates.ComponentDay, "30", 5, isodates.ReasonNonexistent)
+}
+
+func (suite *ParseErrorSuite) TestFiscalAndRetail() {
+	_, err := isodates.USFederalFiscalCalendar.ParseFiscalYear("FX2020")
+	suite.assertParseError(err, "FYYYYY", "FX2020", "", "", 1, isodates.ReasonInvalidFormat)
+
+	_, _, err = isodates.USFederalFiscalCalendar.ParseFiscalQuarter("FY2020-Q5")
+	suite.assertParseError(err, "FYYYYY-Q#", "FY2020-Q5", isodates.ComponentQuarter, "5", 8, isodates.ReasonOutOfRange)
+
+	_, _, err = isodates.NRFRetailCalendar.ParseRetailPeriod("2019-P13")
+	suite.assertParseError(err, "YYYY-P##", "2019-P13", isodates.ComponentPeriod, "13", 6, isodates.ReasonOutOfRange)
+
+	_, _, err = isodates.NRFRetailCalendar.ParseRetailWeek("2019-W53")
+	suite.assertParseError(err, "YYYY-W##", "2019-W53", isodates.ComponentWeek, "53", 6, isodates.ReasonNonexistent)
+}
+
+func (suite *ParseErrorSuite) TestDateTime() {
+	_, err := isodates.ParseDateTime("2019-03-04X06:04:33Z")
+	suite.assertParseError(err, "YYYY-MM-DDThh:mm:ssZ", "2019-03-04X06:04:33Z", "", "", 10, isodates.ReasonInvalidFormat)
+
+	var timeErr *time.ParseError
+	suite.True(errors.As(err, &timeErr))
+}
+
+func (suite *ParseErrorSuite) TestError() {
+	_, _, err := isodates.ParseWeek("2019-W5")
+	suite.EqualError(err, "invalid YYYY-W## format: 2019-W5")
+
+	_, _, err = isodates.ParseWeek("2019-W54")
+	suite.EqualError(err, "invalid week number: 54")
+
+	_, _, err = isodates.ParseWeek("2019-W53")
+	suite.EqualError(err, "invalid week number: 53 (2019 has 52 weeks)")
+
+	_, _, _, err = isodates.ParseDate("2019-04-31")
+	suite.EqualError(err, "invalid day of month: 31 (April 2019 has 30 days)")
+
+	_, _, _, err = isodates.ParseWeekDay("2019-W05-9")
+	suite.EqualError(err, "invalid week offset: 9")
+}
+
+func (suite *ParseErrorSuite) TestErrNilLocation() {
+	_, err := isodates.ParseDateStartIn("2019-05-22", nil)
+	suite.True(errors.Is(err, isodates.ErrNilLocation))
+	suite.EqualError(err, "parse date start: nil location")
+
+	_, err = isodates.USWeeks.ParseWeekEndIn("2019-W05", nil)
+	suite.True(errors.Is(err, isodates.ErrNilLocation))
+
+	_, _, err = isodates.NextMonthDayOccurrence("--12-25", time.Now(), nil)
+	suite.True(errors.Is(err, isodates.ErrNilLocation))
+
+	_, _, _, err = isodates.ParseDate("2019-02-29")
+	suite.False(errors.Is(err, isodates.ErrNilLocation))
+}
+
+func ExampleParseError() {
+	_, _, _, err := isodates.ParseDate("2019-13-01")
+
+	var parseErr *isodates.ParseError
+	if errors.As(err, &parseErr) {
+		fmt.Println(parseErr.Component, parseErr.Offset, parseErr.Reason)
+	}
+
+	// Output: month 5 out_of_range
+}
@@ -0,0 +1,182 @@
+package isodates_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestParseSuite(t *testing.T) {
+	suite.Run(t, new(ParseSuite))
+}
+
+type ParseSuite struct {
+	ChronoSuite
+}
+
+func (suite *ParseSuite) TestParse() {
+	succeeds := func(input string, expected isodates.Value) {
+		value, err := isodates.Parse(input)
+		_ = suite.NoError(err, input) && suite.Equal(expected, value, input)
+	}
+	fails := func(input string) {
+		_, err := isodates.Parse(input)
+		suite.Error(err, input)
+	}
+	fails("")
+	fails("2019")
+	fails("garbage")
+	fails("2019-05-2")
+	fails("2019-13-01")
+	fails("2019-02-29")
+	fails("2019-W53")
+	fails("2019-W05-8")
+	fails("2019-13")
+	fails("--02-30")
+	fails("2019-05-22T25:00:00Z")
+	fails("2019142")
+	fails("201905")
+	fails("+2019")
+	fails("2019W5")
+	fails("20190230")
+	fails("+-0190522")
+
+	succeeds("2019-05-22", isodates.Value{Kind: isodates.KindDate, Year: 2019, Month: time.May, Day: 22})
+	succeeds("2019-W05", isodates.Value{Kind: isodates.KindWeek, Year: 2019, Week: 5})
+	succeeds("2020-W53", isodates.Value{Kind: isodates.KindWeek, Year: 2020, Week: 53})
+	succeeds("2019-W05-3", isodates.Value{Kind: isodates.KindWeekDay, Year: 2019, Week: 5, WeekDay: 3})
+	succeeds("2019-05", isodates.Value{Kind: isodates.KindYearMonth, Year: 2019, Month: time.May})
+	succeeds("+2019-05", isodates.Value{Kind: isodates.KindYearMonth, Year: 2019, Month: time.May})
+	succeeds("-2019-05", isodates.Value{Kind: isodates.KindYearMonth, Year: -2019, Month: time.May})
+	succeeds("--05-22", isodates.Value{Kind: isodates.KindMonthDay, Month: time.May, Day: 22})
+	succeeds("--5-2", isodates.Value{Kind: isodates.KindMonthDay, Month: time.May, Day: 2})
+
+	// Basic formats and expanded years are detected just like the individual parsers accept them.
+	succeeds("20190522", isodates.Value{Kind: isodates.KindDate, Year: 2019, Month: time.May, Day: 22})
+	succeeds("+10000-01-01", isodates.Value{Kind: isodates.KindDate, Year: 10000, Month: time.January, Day: 1})
+	succeeds("-0044-03-15", isodates.Value{Kind: isodates.KindDate, Year: -44, Month: time.March, Day: 15})
+	succeeds("+0020190522", isodates.Value{Kind: isodates.KindDate, Year: 2019, Month: time.May, Day: 22})
+	succeeds("2019W05", isodates.Value{Kind: isodates.KindWeek, Year: 2019, Week: 5})
+	succeeds("+002019-W05", isodates.Value{Kind: isodates.KindWeek, Year: 2019, Week: 5})
+	succeeds("-0044W10", isodates.Value{Kind: isodates.KindWeek, Year: -44, Week: 10})
+	succeeds("2019W053", isodates.Value{Kind: isodates.KindWeekDay, Year: 2019, Week: 5, WeekDay: 3})
+	succeeds("+10000-W05-3", isodates.Value{Kind: isodates.KindWeekDay, Year: 10000, Week: 5, WeekDay: 3})
+	succeeds("+002019-05", isodates.Value{Kind: isodates.KindYearMonth, Year: 2019, Month: time.May})
+	succeeds("-0044-03", isodates.Value{Kind: isodates.KindYearMonth, Year: -44, Month: time.March})
+	succeeds("--0522", isodates.Value{Kind: isodates.KindMonthDay, Month: time.May, Day: 22})
+
+	value, err := isodates.Parse("2019-05-22T12:33:53Z")
+	_ = suite.NoError(err) &&
+		suite.Equal(isodates.KindDateTime, value.Kind) &&
+		suite.Equal(2019, value.Year) &&
+		suite.Equal(time.May, value.Month) &&
+		suite.Equal(22, value.Day) &&
+		suite.True(time.Date(2019, time.May, 22, 12, 33, 53, 0, time.UTC).Equal(value.DateTime))
+}
+
+func (suite *ParseSuite) TestParseError() {
+	// Once we know the kind, errors come from that kind's parser.
+	_, err := isodates.Parse("2019-W5x")
+	var parseErr *isodates.ParseError
+	_ = suite.True(errors.As(err, &parseErr)) &&
+		suite.Equal("YYYY-W##", parseErr.Format) &&
+		suite.Equal(isodates.ComponentWeek, parseErr.Component)
+
+	_, err = isodates.Parse("garbage")
+	_ = suite.True(errors.As(err, &parseErr)) &&
+		suite.Equal(isodates.ReasonInvalidFormat, parseErr.Reason)
+
+	// Malformed weeks are reported against the week format, not some other kind that has the same length.
+	_, err = isodates.Parse("2019-W5")
+	suite.EqualError(err, "invalid YYYY-W## format: 2019-W5")
+	_, err = isodates.Parse("2019W5")
+	suite.EqualError(err, "invalid YYYY-W## format: 2019W5")
+	_, err = isodates.Parse("2019-W05-")
+	suite.EqualError(err, "invalid YYYY-W##-# format: 2019-W05-")
+}
+
+func (suite *ParseSuite) TestStart() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		value, err := isodates.Parse(input)
+		suite.Require().NoError(err)
+		start, err := value.Start(loc)
+		suite.AssertMidnightIn(start, err, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		value, err := isodates.Parse(input)
+		suite.Require().NoError(err)
+		_, err = value.Start(loc)
+		suite.Error(err)
+	}
+	fails("2019-05-22", nil)
+	fails("--05-22", time.UTC)
+	fails("XXXX-05-22", time.UTC)
+
+	succeeds("2019-05-22", time.UTC, 2019, time.May, 22)
+	succeeds("2019-05-22", locationEDT, 2019, time.May, 22)
+	succeeds("2019-W05", locationEDT, 2019, time.January, 28)
+	succeeds("2019-W01", time.UTC, 2018, time.December, 31)
+	succeeds("2019-W05-3", locationPDT, 2019, time.January, 30)
+	succeeds("2019-05", locationEDT, 2019, time.May, 1)
+
+	value, _ := isodates.Parse("2019-05-22T12:33:53Z")
+	start, err := value.Start(locationEDT)
+	suite.AssertTime(start, err, 2019, time.May, 22, 8, 33, 53, 0)
+	suite.Equal(locationEDT, start.Location())
+}
+
+func (suite *ParseSuite) TestEnd() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		value, err := isodates.Parse(input)
+		suite.Require().NoError(err)
+		end, err := value.End(loc)
+		suite.AssertAlmostMidnightIn(end, err, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		value, err := isodates.Parse(input)
+		suite.Require().NoError(err)
+		_, err = value.End(loc)
+		suite.Error(err)
+	}
+	fails("2019-05-22", nil)
+	fails("--05-22", time.UTC)
+	fails("XXXX-05-22", time.UTC)
+
+	succeeds("2019-05-22", time.UTC, 2019, time.May, 22)
+	succeeds("2019-W05", locationEDT, 2019, time.February, 3)
+	succeeds("2020-W53", time.UTC, 2021, time.January, 3)
+	succeeds("2019-W05-3", locationPDT, 2019, time.January, 30)
+	succeeds("2019-02", locationEDT, 2019, time.February, 28)
+	succeeds("2020-02", locationEDT, 2020, time.February, 29)
+
+	value, _ := isodates.Parse("2019-05-22T12:33:53Z")
+	end, err := value.End(time.UTC)
+	suite.AssertTime(end, err, 2019, time.May, 22, 12, 33, 53, 0)
+}
+
+func (suite *ParseSuite) TestKindString() {
+	suite.Equal("date", isodates.KindDate.String())
+	suite.Equal("date-time", isodates.KindDateTime.String())
+	suite.Equal("week", isodates.KindWeek.String())
+	suite.Equal("week-day", isodates.KindWeekDay.String())
+	suite.Equal("year-month", isodates.KindYearMonth.String())
+	suite.Equal("month-day", isodates.KindMonthDay.String())
+	suite.Equal("Kind(0)", isodates.Kind(0).String())
+}
+
+func ExampleParse() {
+	for _, period := range []string{"2019-05-22", "2019-W05", "2019-05"} {
+		value, _ := isodates.Parse(period)
+		start, _ := value.Start(time.UTC)
+		end, _ := value.End(time.UTC)
+		fmt.Println(value.Kind, start.Format("Jan 2"), "-", end.Format("Jan 2"))
+	}
+
+	// Output: date May 22 - May 22
+	// week Jan 28 - Feb 3
+	// year-month May 1 - May 31
+}
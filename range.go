@@ -0,0 +1,197 @@
+package isodates
+
+import (
+	"math"
+	"time"
+)
+
+// Range is a span of time from Start through End. By default both ends are inclusive, so the range
+// for a date/week/month is from midnight on its first day through 11:59:59pm on its last day; exactly
+// what you get from the separate ParseXyzStart/ParseXyzEnd functions. Use HalfOpen() to get the
+// exclusive-end version of a range instead. A range can also be unbounded on either side (e.g. "every
+// instant from 2019-01-01 on"), which is different from the zero Range that doesn't contain anything.
+type Range struct {
+	// Start is the first instant in the range.
+	Start time.Time
+	// End is the last instant in the range or, when Exclusive is true, the first instant after it.
+	End time.Time
+	// Exclusive indicates that End is not part of the range (i.e. it's a half-open range). The
+	// exclusive end of a date/week/month is midnight at the start of the following day.
+	Exclusive bool
+	// LowerUnbounded indicates that the range has no start, so it includes every instant up to the
+	// End. Start is ignored when this is set.
+	LowerUnbounded bool
+	// UpperUnbounded indicates that the range has no end, so it includes every instant from the Start
+	// on. End and Exclusive are ignored when this is set.
+	UpperUnbounded bool
+}
+
+// HalfOpen returns the equivalent range whose End is exclusive. For ranges that end at 11:59:59pm
+// (i.e. the ParseXyzRange functions), the new End is midnight at the start of the following day in the
+// End's location, whatever EndPrecision the range was built with, so you can use "ts >= Start AND ts < End"
+// in queries. Ranges that are already half-open (or that have no end at all) are returned as-is.
+func (r Range) HalfOpen() Range {
+	if r.Exclusive || r.UpperUnbounded {
+		return r
+	}
+	r.End = instantAfter(r.End)
+	r.Exclusive = true
+	return r
+}
+
+// Contains returns true if the date/time falls anywhere between Start and End. The End only counts
+// if the range is not Exclusive, and an unbounded side contains everything in that direction.
+func (r Range) Contains(t time.Time) bool {
+	return (r.LowerUnbounded || !t.Before(r.Start)) && r.endsAfter(t)
+}
+
+// Overlaps returns true if the two ranges have at least one instant in common.
+func (r Range) Overlaps(other Range) bool {
+	return r.startsBeforeEndOf(other) && other.startsBeforeEndOf(r)
+}
+
+// Intersect returns the portion of time that both ranges have in common. The boolean is false (and
+// the range is empty) if the ranges don't overlap at all.
+func (r Range) Intersect(other Range) (Range, bool) {
+	if !r.Overlaps(other) {
+		return Range{}, false
+	}
+	start, end := r.laterStart(other), r.earlierEnd(other)
+	return Range{
+		Start:          start.Start,
+		End:            end.End,
+		Exclusive:      end.Exclusive,
+		LowerUnbounded: start.LowerUnbounded,
+		UpperUnbounded: end.UpperUnbounded,
+	}, true
+}
+
+// Union returns a single range that covers both ranges. The boolean is false (and the range is empty)
+// if there's a gap between them, since the result would include time that neither range covers. Ranges
+// that are back to back (e.g. one week's end and the next week's start) are considered adjacent.
+func (r Range) Union(other Range) (Range, bool) {
+	if !r.Overlaps(other) && !r.adjacent(other) && !other.adjacent(r) {
+		return Range{}, false
+	}
+	start, end := r.earlierStart(other), r.laterEnd(other)
+	return Range{
+		Start:          start.Start,
+		End:            end.End,
+		Exclusive:      end.Exclusive,
+		LowerUnbounded: start.LowerUnbounded,
+		UpperUnbounded: end.UpperUnbounded,
+	}, true
+}
+
+// Duration returns the amount of time between Start and End. When End is inclusive it's the last
+// instant in the range, so a single day's range is one EndPrecision short of 24 hours. A half-open
+// day's range is exactly as long as that day. Unbounded ranges go on forever, so you get the longest
+// possible time.Duration.
+func (r Range) Duration() time.Duration {
+	if r.LowerUnbounded || r.UpperUnbounded {
+		return math.MaxInt64
+	}
+	return r.End.Sub(r.Start)
+}
+
+// Equal returns true if both ranges start and end at the same instants (even if they're expressed in
+// different time zones) and both have the same kind of End. Unbounded sides are equal no matter what
+// their Start/End fields contain.
+func (r Range) Equal(other Range) bool {
+	if r.LowerUnbounded != other.LowerUnbounded || r.UpperUnbounded != other.UpperUnbounded {
+		return false
+	}
+	if !r.LowerUnbounded && !r.Start.Equal(other.Start) {
+		return false
+	}
+	return r.UpperUnbounded || (r.End.Equal(other.End) && r.Exclusive == other.Exclusive)
+}
+
+// isZero returns true if this is the zero Range, which is how we represent an empty range.
+func (r Range) isZero() bool {
+	return r.Start.IsZero() && r.End.IsZero() && !r.Exclusive && !r.LowerUnbounded && !r.UpperUnbounded
+}
+
+// endsAfter returns true if the range hasn't ended yet at the given instant.
+func (r Range) endsAfter(t time.Time) bool {
+	switch {
+	case r.UpperUnbounded:
+		return true
+	case r.Exclusive:
+		return t.Before(r.End)
+	default:
+		return !t.After(r.End)
+	}
+}
+
+// startsBeforeEndOf returns true if this range starts before the other range is over.
+func (r Range) startsBeforeEndOf(other Range) bool {
+	return r.LowerUnbounded || other.endsAfter(r.Start)
+}
+
+// earlierStart returns whichever range starts first.
+func (r Range) earlierStart(other Range) Range {
+	if r.LowerUnbounded || (!other.LowerUnbounded && !other.Start.Before(r.Start)) {
+		return r
+	}
+	return other
+}
+
+// laterStart returns whichever range starts last.
+func (r Range) laterStart(other Range) Range {
+	if other.LowerUnbounded || (!r.LowerUnbounded && !r.Start.Before(other.Start)) {
+		return r
+	}
+	return other
+}
+
+// earlierEnd returns whichever range finishes first. When they have the same End, the exclusive one
+// finishes first since it doesn't include that instant.
+func (r Range) earlierEnd(other Range) Range {
+	switch {
+	case r.UpperUnbounded:
+		return other
+	case other.UpperUnbounded:
+		return r
+	case r.End.Before(other.End):
+		return r
+	case other.End.Before(r.End):
+		return other
+	case other.Exclusive:
+		return other
+	default:
+		return r
+	}
+}
+
+// laterEnd returns whichever range finishes last. When they have the same End, the inclusive one
+// finishes last since it includes that instant.
+func (r Range) laterEnd(other Range) Range {
+	switch {
+	case r.UpperUnbounded:
+		return r
+	case other.UpperUnbounded:
+		return other
+	case r.End.After(other.End):
+		return r
+	case other.End.After(r.End):
+		return other
+	case r.Exclusive:
+		return other
+	default:
+		return r
+	}
+}
+
+// adjacent returns true if the other range begins the instant after this one ends. For inclusive ranges,
+// that's the same exclusive end that HalfOpen() would give you.
+func (r Range) adjacent(other Range) bool {
+	switch {
+	case r.UpperUnbounded || other.LowerUnbounded:
+		return false
+	case r.Exclusive:
+		return other.Start.Equal(r.End)
+	default:
+		return other.Start.Equal(instantAfter(r.End))
+	}
+}
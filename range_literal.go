@@ -0,0 +1,135 @@
+package isodates
+
+import (
+	"strings"
+	"time"
+)
+
+/*
+ * Postgres represents daterange/tstzrange values as literals such as "[2019-01-01,2019-02-01)". The
+ * brackets say whether each bound is inclusive ('[' and ']') or exclusive ('(' and ')'), a missing or
+ * "infinity" bound is unbounded, and "empty" is a range with no instants at all. These functions
+ * convert between those literals and Ranges using the same conventions as the rest of the package.
+ */
+
+// rangeLiteralFormat is the format we report when a range literal doesn't parse.
+const rangeLiteralFormat = "[start,end], (start,end), or empty"
+
+// rangeBoundLayouts are the timestamp formats we accept inside of a range literal: RFC 3339 (what we
+// write) and the formats that Postgres writes for tstzrange values.
+var rangeBoundLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999Z07:00",
+}
+
+// ParseRangeLiteral accepts a Postgres range literal such as "[2019-01-01,2019-02-01)" or
+// `["2019-05-22 00:00:00+00","2019-05-23 00:00:00+00")` and returns the equivalent Range. Bounds can be
+// dates, which are in UTC, or timestamps in RFC 3339 or Postgres' own format.
+//
+// Date bounds follow the usual conventions: an inclusive end such as "2019-01-31]" is 11:59:59pm on
+// that day (see AlmostMidnight) and an exclusive end such as "2019-02-01)" is midnight at the start of
+// that day, so both examples cover all of January. An exclusive start such as "(2018-12-31" begins at
+// midnight on the following day, and an exclusive timestamp start begins one EndPrecision later, since
+// a Range's Start is always inclusive.
+//
+// An empty or "infinity" bound is unbounded, so you get a range with LowerUnbounded or UpperUnbounded
+// set (e.g. "[2019-01-01,)" contains every instant from 2019 on). The literal "empty" is the zero Range,
+// which doesn't contain anything.
+func ParseRangeLiteral(input string) (Range, error) {
+	if strings.EqualFold(input, "empty") {
+		return Range{}, nil
+	}
+
+	comma := strings.IndexByte(input, ',')
+	switch {
+	case len(input) < 3 || (input[0] != '[' && input[0] != '('):
+		return Range{}, formatError(rangeLiteralFormat, input, 0)
+	case comma < 0:
+		return Range{}, formatError(rangeLiteralFormat, input, len(input))
+	}
+
+	last := len(input) - 1
+	if input[last] != ']' && input[last] != ')' {
+		return Range{}, formatError(rangeLiteralFormat, input, last)
+	}
+	start, lowerUnbounded, err := parseRangeBound(input, 1, comma, input[0] == '[', true)
+	if err != nil {
+		return Range{}, err
+	}
+	end, upperUnbounded, err := parseRangeBound(input, comma+1, last, input[last] == ']', false)
+	if err != nil {
+		return Range{}, err
+	}
+	return Range{
+		Start:          start,
+		End:            end,
+		Exclusive:      input[last] == ')' && !upperUnbounded,
+		LowerUnbounded: lowerUnbounded,
+		UpperUnbounded: upperUnbounded,
+	}, nil
+}
+
+// FormatRangeLiteral returns the Postgres range literal for the range, such as
+// "[2019-05-22T00:00:00Z,2019-05-23T00:00:00Z)". Bounds are written as RFC 3339 timestamps, which
+// both tstzrange and daterange columns accept. The start is always inclusive and the end uses ']' or
+// ')' depending on whether the range is Exclusive. Unbounded sides are written without a bound (e.g.
+// "[2019-01-01T00:00:00Z,)"), and the zero Range is written as "empty".
+func FormatRangeLiteral(r Range) string {
+	if r.isZero() {
+		return "empty"
+	}
+
+	lower, upper := "[", "]"
+	if r.Exclusive {
+		upper = ")"
+	}
+	start, end := "", ""
+	if r.LowerUnbounded {
+		lower = "("
+	} else {
+		start = r.Start.Format(time.RFC3339Nano)
+	}
+	if r.UpperUnbounded {
+		upper = ")"
+	} else {
+		end = r.End.Format(time.RFC3339Nano)
+	}
+	return lower + start + "," + end + upper
+}
+
+// parseRangeBound parses the date or timestamp found at input[start:end], which may be wrapped in
+// double quotes. The flags indicate whether the bracket next to it was inclusive and whether this is
+// the lower bound, which determines the time of day that a date bound maps to. The boolean is true if
+// the bound is unbounded (i.e. it's missing or infinite).
+func parseRangeBound(input string, start int, end int, inclusive bool, lower bool) (time.Time, bool, error) {
+	bound := strings.Trim(input[start:end], `" `)
+	switch strings.ToLower(bound) {
+	case "", "infinity", "-infinity":
+		return ZeroTime, true, nil
+	}
+
+	for _, layout := range rangeBoundLayouts {
+		if timestamp, err := time.Parse(layout, bound); err == nil {
+			if lower && !inclusive {
+				return timestamp.Add(endPrecision()), false, nil
+			}
+			return timestamp, false, nil
+		}
+	}
+
+	date, err := ParseDateValue(bound)
+	if err != nil {
+		return ZeroTime, false, formatError(rangeLiteralFormat, input, start)
+	}
+	switch {
+	case lower && inclusive:
+		return Midnight(date.Year, date.Month, date.Day, time.UTC), false, nil
+	case lower:
+		return Midnight(date.Year, date.Month, date.Day+1, time.UTC), false, nil
+	case inclusive:
+		return AlmostMidnight(date.Year, date.Month, date.Day, time.UTC), false, nil
+	default:
+		return Midnight(date.Year, date.Month, date.Day, time.UTC), false, nil
+	}
+}
@@ -0,0 +1,138 @@
+package isodates_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRangeLiteralSuite(t *testing.T) {
+	suite.Run(t, new(RangeLiteralSuite))
+}
+
+type RangeLiteralSuite struct {
+	ChronoSuite
+}
+
+func (suite *RangeLiteralSuite) TestParseRangeLiteral() {
+	succeeds := func(input string, expected isodates.Range) {
+		actual, err := isodates.ParseRangeLiteral(input)
+		_ = suite.NoError(err, input) && suite.True(expected.Equal(actual), "%s: %v", input, actual)
+	}
+	fails := func(input string) {
+		_, err := isodates.ParseRangeLiteral(input)
+		suite.Error(err, input)
+	}
+
+	// Date bounds, which is what you get from a daterange column.
+	january := days(2019, time.January, 1, 2019, time.January, 31)
+	succeeds("[2019-01-01,2019-02-01)", january.HalfOpen())
+	succeeds("[2019-01-01,2019-01-31]", january)
+	succeeds("(2018-12-31,2019-01-31]", january)
+	succeeds("(2018-12-31,2019-02-01)", january.HalfOpen())
+	succeeds("[2020-02-29,2020-03-01)", day(2020, time.February, 29).HalfOpen())
+
+	// Timestamp bounds, which is what you get from a tstzrange column.
+	succeeds("[2019-05-22T00:00:00Z,2019-05-22T23:59:59.999999999Z]", day(2019, time.May, 22))
+	succeeds(`["2019-05-22 00:00:00+00","2019-05-23 00:00:00+00")`, day(2019, time.May, 22).HalfOpen())
+	succeeds(`["2019-05-22 00:00:00-04","2019-05-23 00:00:00-04")`, isodates.Range{
+		Start:     isodates.Midnight(2019, time.May, 22, locationEDT),
+		End:       isodates.Midnight(2019, time.May, 23, locationEDT),
+		Exclusive: true,
+	})
+	succeeds(`("2019-05-22 10:30:00.5+05:30","2019-05-22 11:00:00+05:30"]`, isodates.Range{
+		Start: time.Date(2019, time.May, 22, 5, 0, 0, 500000001, time.UTC),
+		End:   time.Date(2019, time.May, 22, 5, 30, 0, 0, time.UTC),
+	})
+
+	// Unbounded sides are flagged as such, and empty ranges are the zero range.
+	from2019 := isodates.Range{Start: isodates.Midnight(2019, time.January, 1, time.UTC), UpperUnbounded: true}
+	succeeds("[2019-01-01,)", from2019)
+	succeeds("[2019-01-01,infinity)", from2019)
+	succeeds("[2019-01-01,infinity]", from2019)
+	succeeds(`(,"2019-02-01")`, isodates.Range{End: isodates.Midnight(2019, time.February, 1, time.UTC), Exclusive: true, LowerUnbounded: true})
+	succeeds("[-infinity,2019-01-31]", isodates.Range{End: isodates.AlmostMidnight(2019, time.January, 31, time.UTC), LowerUnbounded: true})
+	succeeds("(,)", isodates.Range{LowerUnbounded: true, UpperUnbounded: true})
+	succeeds("[-infinity,infinity]", isodates.Range{LowerUnbounded: true, UpperUnbounded: true})
+	succeeds("empty", isodates.Range{})
+	succeeds("EMPTY", isodates.Range{})
+
+	fails("")
+	fails("2019-01-01,2019-02-01)")
+	fails("{2019-01-01,2019-02-01)")
+	fails("[2019-01-01,2019-02-01")
+	fails("[2019-01-01]")
+	fails("[2019-02-30,2019-03-01)")
+	fails("[2019-01-01,2019-W05)")
+	fails("[2019-01-01,nope)")
+	fails(" empty")
+}
+
+func (suite *RangeLiteralSuite) TestParseRangeLiteralErrors() {
+	_, err := isodates.ParseRangeLiteral("[2019-01-01,2019-02-30)")
+	suite.EqualError(err, "invalid [start,end], (start,end), or empty format: [2019-01-01,2019-02-30)")
+
+	var parseErr *isodates.ParseError
+	_ = suite.True(errors.As(err, &parseErr)) && suite.Equal(12, parseErr.Offset)
+}
+
+func (suite *RangeLiteralSuite) TestFormatRangeLiteral() {
+	suite.Equal("[2019-05-22T00:00:00Z,2019-05-22T23:59:59.999999999Z]",
+		isodates.FormatRangeLiteral(day(2019, time.May, 22)))
+	suite.Equal("[2019-05-22T00:00:00Z,2019-05-23T00:00:00Z)",
+		isodates.FormatRangeLiteral(day(2019, time.May, 22).HalfOpen()))
+	suite.Equal("[2019-05-22T00:00:00-04:00,2019-05-23T00:00:00-04:00)",
+		isodates.FormatRangeLiteral(isodates.Range{
+			Start:     isodates.Midnight(2019, time.May, 22, locationEDT),
+			End:       isodates.Midnight(2019, time.May, 23, locationEDT),
+			Exclusive: true,
+		}))
+	suite.Equal("[2019-05-22T00:00:00Z,)",
+		isodates.FormatRangeLiteral(isodates.Range{Start: isodates.Midnight(2019, time.May, 22, time.UTC), UpperUnbounded: true}))
+	suite.Equal("(,2019-05-22T23:59:59.999999999Z]",
+		isodates.FormatRangeLiteral(isodates.Range{End: isodates.AlmostMidnight(2019, time.May, 22, time.UTC), LowerUnbounded: true}))
+	suite.Equal("(,)", isodates.FormatRangeLiteral(isodates.Range{LowerUnbounded: true, UpperUnbounded: true}))
+	suite.Equal("empty", isodates.FormatRangeLiteral(isodates.Range{}))
+
+	// The zero time is just another instant unless the side is flagged as unbounded.
+	suite.Equal("[0001-01-01T00:00:00Z,2019-05-22T23:59:59.999999999Z]",
+		isodates.FormatRangeLiteral(isodates.Range{End: isodates.AlmostMidnight(2019, time.May, 22, time.UTC)}))
+}
+
+func (suite *RangeLiteralSuite) TestRoundTrip() {
+	ranges := []isodates.Range{
+		day(2019, time.May, 22),
+		day(2019, time.May, 22).HalfOpen(),
+		days(2019, time.January, 1, 2019, time.December, 31),
+		{Start: isodates.Midnight(2019, time.May, 22, time.UTC), UpperUnbounded: true},
+		{End: isodates.AlmostMidnight(2019, time.May, 22, time.UTC), LowerUnbounded: true},
+		{End: isodates.Midnight(2019, time.May, 22, time.UTC), Exclusive: true, LowerUnbounded: true},
+		{LowerUnbounded: true, UpperUnbounded: true},
+		{},
+	}
+	for _, r := range ranges {
+		literal := isodates.FormatRangeLiteral(r)
+		actual, err := isodates.ParseRangeLiteral(literal)
+		_ = suite.NoError(err, literal) && suite.True(r.Equal(actual), literal)
+	}
+}
+
+func ExampleParseRangeLiteral() {
+	january, _ := isodates.ParseRangeLiteral("[2019-01-01,2019-02-01)")
+	fmt.Println(january.Start, "->", january.End, january.Exclusive)
+
+	january, _ = isodates.ParseRangeLiteral("[2019-01-01,2019-01-31]")
+	fmt.Println(january.Start, "->", january.End, january.Exclusive)
+
+	from2019, _ := isodates.ParseRangeLiteral("[2019-01-01,)")
+	fmt.Println(from2019.UpperUnbounded, from2019.Contains(time.Date(2525, time.January, 1, 0, 0, 0, 0, time.UTC)))
+
+	// Output:
+	// 2019-01-01 00:00:00 +0000 UTC -> 2019-02-01 00:00:00 +0000 UTC true
+	// 2019-01-01 00:00:00 +0000 UTC -> 2019-01-31 23:59:59.999999999 +0000 UTC false
+	// true true
+}
@@ -0,0 +1,246 @@
+package isodates_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRangeSuite(t *testing.T) {
+	suite.Run(t, new(RangeSuite))
+}
+
+type RangeSuite struct {
+	ChronoSuite
+}
+
+// day returns the range for the given date in UTC.
+func day(year int, month time.Month, day int) isodates.Range {
+	return isodates.Range{
+		Start: isodates.Midnight(year, month, day, time.UTC),
+		End:   isodates.AlmostMidnight(year, month, day, time.UTC),
+	}
+}
+
+// days returns the range from the start of the first date through the end of the last date in UTC.
+func days(startYear int, startMonth time.Month, startDay int, endYear int, endMonth time.Month, endDay int) isodates.Range {
+	return isodates.Range{
+		Start: isodates.Midnight(startYear, startMonth, startDay, time.UTC),
+		End:   isodates.AlmostMidnight(endYear, endMonth, endDay, time.UTC),
+	}
+}
+
+func (suite *RangeSuite) TestContains() {
+	r := day(2019, time.May, 22)
+	suite.True(r.Contains(time.Date(2019, time.May, 22, 0, 0, 0, 0, time.UTC)))
+	suite.True(r.Contains(time.Date(2019, time.May, 22, 12, 30, 0, 0, time.UTC)))
+	suite.True(r.Contains(time.Date(2019, time.May, 22, 23, 59, 59, 999999999, time.UTC)))
+	suite.True(r.Contains(time.Date(2019, time.May, 22, 8, 0, 0, 0, locationEDT)))
+
+	suite.False(r.Contains(time.Date(2019, time.May, 21, 23, 59, 59, 999999999, time.UTC)))
+	suite.False(r.Contains(time.Date(2019, time.May, 23, 0, 0, 0, 0, time.UTC)))
+	suite.False(r.Contains(time.Date(2019, time.May, 22, 22, 0, 0, 0, locationEDT)))
+	suite.False(r.Contains(time.Time{}))
+}
+
+func (suite *RangeSuite) TestOverlaps() {
+	r := days(2019, time.May, 10, 2019, time.May, 20)
+	suite.True(r.Overlaps(r))
+	suite.True(r.Overlaps(day(2019, time.May, 10)))
+	suite.True(r.Overlaps(day(2019, time.May, 20)))
+	suite.True(r.Overlaps(days(2019, time.May, 1, 2019, time.May, 10)))
+	suite.True(r.Overlaps(days(2019, time.May, 1, 2019, time.May, 31)))
+	suite.True(day(2019, time.May, 15).Overlaps(r))
+
+	suite.False(r.Overlaps(day(2019, time.May, 9)))
+	suite.False(r.Overlaps(day(2019, time.May, 21)))
+	suite.False(day(2019, time.May, 21).Overlaps(r))
+}
+
+func (suite *RangeSuite) TestIntersect() {
+	r := days(2019, time.May, 10, 2019, time.May, 20)
+
+	actual, ok := r.Intersect(days(2019, time.May, 15, 2019, time.May, 31))
+	_ = suite.True(ok) && suite.True(days(2019, time.May, 15, 2019, time.May, 20).Equal(actual))
+
+	actual, ok = r.Intersect(days(2019, time.May, 1, 2019, time.May, 12))
+	_ = suite.True(ok) && suite.True(days(2019, time.May, 10, 2019, time.May, 12).Equal(actual))
+
+	actual, ok = r.Intersect(day(2019, time.May, 20))
+	_ = suite.True(ok) && suite.True(day(2019, time.May, 20).Equal(actual))
+
+	actual, ok = r.Intersect(day(2019, time.May, 21))
+	_ = suite.False(ok) && suite.Equal(isodates.Range{}, actual)
+}
+
+func (suite *RangeSuite) TestUnion() {
+	r := days(2019, time.May, 10, 2019, time.May, 20)
+
+	actual, ok := r.Union(days(2019, time.May, 15, 2019, time.May, 31))
+	_ = suite.True(ok) && suite.True(days(2019, time.May, 10, 2019, time.May, 31).Equal(actual))
+
+	actual, ok = r.Union(day(2019, time.May, 15))
+	_ = suite.True(ok) && suite.True(r.Equal(actual))
+
+	// Back to back ranges don't leave a gap.
+	actual, ok = r.Union(day(2019, time.May, 21))
+	_ = suite.True(ok) && suite.True(days(2019, time.May, 10, 2019, time.May, 21).Equal(actual))
+
+	actual, ok = r.Union(day(2019, time.May, 9))
+	_ = suite.True(ok) && suite.True(days(2019, time.May, 9, 2019, time.May, 20).Equal(actual))
+
+	actual, ok = r.Union(day(2019, time.May, 22))
+	_ = suite.False(ok) && suite.Equal(isodates.Range{}, actual)
+
+	// Ranges built with a coarser EndPrecision are still back to back after it changes.
+	isodates.EndPrecision = time.Second
+	seconds := days(2019, time.May, 1, 2019, time.May, 9)
+	isodates.EndPrecision = time.Nanosecond
+	actual, ok = r.Union(seconds)
+	_ = suite.True(ok) && suite.True(actual.Start.Equal(seconds.Start)) && suite.True(actual.End.Equal(r.End))
+}
+
+func (suite *RangeSuite) TestDuration() {
+	suite.Equal(24*time.Hour-time.Nanosecond, day(2019, time.May, 22).Duration())
+	suite.Equal(7*24*time.Hour-time.Nanosecond, days(2019, time.May, 20, 2019, time.May, 26).Duration())
+	suite.Equal(time.Duration(0), isodates.Range{}.Duration())
+
+	// Spring forward makes this day an hour shorter.
+	r, err := isodates.ParseDateRangeIn("2019-03-10", locationEDT)
+	_ = suite.NoError(err) && suite.Equal(23*time.Hour-time.Nanosecond, r.Duration())
+}
+
+func (suite *RangeSuite) TestEqual() {
+	r := day(2019, time.May, 22)
+	suite.True(r.Equal(r))
+	suite.True(r.Equal(isodates.Range{Start: r.Start.In(locationEDT), End: r.End.In(locationPDT)}))
+
+	suite.False(r.Equal(day(2019, time.May, 23)))
+	suite.False(r.Equal(isodates.Range{Start: r.Start, End: r.End.Add(time.Nanosecond)}))
+}
+
+func (suite *RangeSuite) TestHalfOpen() {
+	r := day(2019, time.May, 22).HalfOpen()
+	suite.True(r.Exclusive)
+	suite.True(r.Start.Equal(isodates.Midnight(2019, time.May, 22, time.UTC)))
+	suite.True(r.End.Equal(isodates.Midnight(2019, time.May, 23, time.UTC)))
+	suite.Equal(24*time.Hour, r.Duration())
+	suite.True(r.Equal(r.HalfOpen()))
+
+	// Fall back makes this day an hour longer.
+	fallBack, err := isodates.ParseDateRangeIn("2019-11-03", locationEDT)
+	_ = suite.NoError(err) && suite.Equal(25*time.Hour, fallBack.HalfOpen().Duration())
+
+	// The exclusive end comes from the calendar, not whatever EndPrecision happens to be set to now.
+	isodates.EndPrecision = time.Microsecond
+	micros, err := isodates.ParseDateRangeIn("2019-05-22", locationEDT)
+	isodates.EndPrecision = time.Nanosecond
+	_ = suite.NoError(err) &&
+		suite.True(micros.HalfOpen().End.Equal(isodates.Midnight(2019, time.May, 23, locationEDT))) &&
+		suite.Equal(locationEDT, micros.HalfOpen().End.Location())
+
+	// Ends that aren't 11:59:59pm are just one EndPrecision short of their exclusive end.
+	r = isodates.Range{
+		Start: time.Date(2019, time.May, 22, 10, 0, 0, 0, time.UTC),
+		End:   time.Date(2019, time.May, 22, 10, 59, 59, 999999999, time.UTC),
+	}.HalfOpen()
+	suite.True(r.End.Equal(time.Date(2019, time.May, 22, 11, 0, 0, 0, time.UTC)))
+}
+
+func (suite *RangeSuite) TestExclusive() {
+	r := day(2019, time.May, 22).HalfOpen()
+	suite.True(r.Contains(time.Date(2019, time.May, 22, 0, 0, 0, 0, time.UTC)))
+	suite.True(r.Contains(time.Date(2019, time.May, 22, 23, 59, 59, 999999999, time.UTC)))
+	suite.False(r.Contains(time.Date(2019, time.May, 23, 0, 0, 0, 0, time.UTC)))
+
+	next := day(2019, time.May, 23).HalfOpen()
+	suite.False(r.Overlaps(next))
+	suite.False(next.Overlaps(r))
+	suite.True(r.Overlaps(day(2019, time.May, 22)))
+	suite.False(r.Overlaps(day(2019, time.May, 23)))
+
+	_, ok := r.Intersect(next)
+	suite.False(ok)
+
+	// An inclusive and exclusive range that end at the same instant; the exclusive one ends first.
+	inclusive := isodates.Range{Start: r.Start, End: r.End}
+	actual, ok := r.Intersect(inclusive)
+	_ = suite.True(ok) && suite.True(r.Equal(actual))
+	actual, ok = r.Union(inclusive)
+	_ = suite.True(ok) && suite.True(inclusive.Equal(actual))
+
+	actual, ok = r.Union(next)
+	_ = suite.True(ok) && suite.True(days(2019, time.May, 22, 2019, time.May, 23).HalfOpen().Equal(actual))
+
+	actual, ok = next.Union(day(2019, time.May, 22))
+	_ = suite.True(ok) && suite.True(days(2019, time.May, 22, 2019, time.May, 23).HalfOpen().Equal(actual))
+
+	_, ok = r.Union(day(2019, time.May, 24).HalfOpen())
+	suite.False(ok)
+}
+
+func (suite *RangeSuite) TestUnbounded() {
+	may22 := isodates.Midnight(2019, time.May, 22, time.UTC)
+	from := isodates.Range{Start: may22, UpperUnbounded: true}
+	until := isodates.Range{End: may22, Exclusive: true, LowerUnbounded: true}
+	forever := isodates.Range{LowerUnbounded: true, UpperUnbounded: true}
+
+	suite.True(from.Contains(may22))
+	suite.True(from.Contains(time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)))
+	suite.False(from.Contains(may22.Add(-time.Nanosecond)))
+	suite.True(until.Contains(time.Time{}))
+	suite.True(until.Contains(may22.Add(-time.Nanosecond)))
+	suite.False(until.Contains(may22))
+	suite.True(forever.Contains(time.Time{}))
+	suite.True(forever.Contains(may22))
+
+	// The zero range is empty, which is not the same thing as unbounded.
+	suite.False(isodates.Range{}.Contains(may22))
+	suite.False(forever.Equal(isodates.Range{}))
+
+	suite.True(from.Overlaps(day(2030, time.January, 1)))
+	suite.True(day(2030, time.January, 1).Overlaps(from))
+	suite.False(from.Overlaps(day(2019, time.May, 21)))
+	suite.True(until.Overlaps(day(2019, time.May, 21)))
+	suite.False(until.Overlaps(from))
+	suite.False(from.Overlaps(until))
+	suite.True(forever.Overlaps(from))
+	suite.True(until.Overlaps(forever))
+
+	actual, ok := from.Intersect(day(2030, time.January, 1))
+	_ = suite.True(ok) && suite.True(day(2030, time.January, 1).Equal(actual))
+	actual, ok = forever.Intersect(until)
+	_ = suite.True(ok) && suite.True(until.Equal(actual))
+	actual, ok = from.Intersect(isodates.Range{Start: may22.AddDate(0, 0, 1), UpperUnbounded: true})
+	_ = suite.True(ok) && suite.True(isodates.Range{Start: may22.AddDate(0, 0, 1), UpperUnbounded: true}.Equal(actual))
+
+	// Back to back, so together they cover everything.
+	actual, ok = until.Union(from)
+	_ = suite.True(ok) && suite.True(forever.Equal(actual))
+	_, ok = from.Union(day(2019, time.May, 20))
+	suite.False(ok)
+	actual, ok = from.Union(day(2019, time.May, 21))
+	_ = suite.True(ok) && suite.True(isodates.Range{Start: day(2019, time.May, 21).Start, UpperUnbounded: true}.Equal(actual))
+
+	// The unused Start/End fields don't matter.
+	suite.True(from.Equal(isodates.Range{Start: may22, End: may22, Exclusive: true, UpperUnbounded: true}))
+	suite.False(from.Equal(isodates.Range{Start: may22}))
+	suite.True(from.Equal(from.HalfOpen()))
+	suite.True(until.Equal(isodates.Range{Start: may22, End: may22, Exclusive: true, LowerUnbounded: true}))
+
+	suite.Equal(time.Duration(math.MaxInt64), from.Duration())
+	suite.Equal(time.Duration(math.MaxInt64), until.Duration())
+}
+
+func ExampleRange_Contains() {
+	week, _ := isodates.ParseWeekRange("2019-W05")
+	fmt.Println(week.Contains(time.Date(2019, time.February, 1, 12, 0, 0, 0, time.UTC)))
+	fmt.Println(week.Contains(time.Date(2019, time.February, 4, 12, 0, 0, 0, time.UTC)))
+
+	// Output: true
+	// false
+}
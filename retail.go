@@ -0,0 +1,354 @@
+package isodates
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/snabb/isoweek"
+)
+
+// RetailPattern describes how the 13 weeks in each quarter of a retail year are split into
+// its three periods (i.e. months).
+type RetailPattern int
+
+const (
+	// Pattern445 splits each quarter into periods of 4, 4, and 5 weeks.
+	Pattern445 RetailPattern = iota
+	// Pattern454 splits each quarter into periods of 4, 5, and 4 weeks.
+	Pattern454
+	// Pattern544 splits each quarter into periods of 5, 4, and 4 weeks.
+	Pattern544
+)
+
+// NRFRetailCalendar is the 4-5-4 calendar published by the National Retail Federation. Each retail year
+// begins on the Sunday nearest to February 1st and is named for the calendar year it begins in.
+var NRFRetailCalendar = RetailCalendar{
+	Pattern:      Pattern454,
+	StartWeekday: time.Sunday,
+	AnchorMonth:  time.February,
+	AnchorDay:    1,
+}
+
+// RetailCalendar describes a 52/53-week retail year that is split into 12 periods using a 4-4-5,
+// 4-5-4, or 5-4-4 pattern of weeks. Just like ISO weeks, each year begins on the weekday that is
+// nearest to some fixed anchor date, so every year contains a whole number of weeks. When a year
+// has a 53rd week, it is added to the last period of the year.
+//
+// Retail years are named for the calendar year in which they begin. Periods are identified using
+// strings such as "2019-P03" and weeks within the retail year using strings such as "2019-W05".
+type RetailCalendar struct {
+	// Pattern determines how many weeks are in each of the year's periods.
+	Pattern RetailPattern
+	// StartWeekday is the day of the week that every retail week begins on.
+	StartWeekday time.Weekday
+	// AnchorMonth is the month of the date that the retail year's first day is nearest to.
+	AnchorMonth time.Month
+	// AnchorDay is the day of the month of the date that the retail year's first day is nearest to.
+	AnchorDay int
+}
+
+// WeeksInYear returns the number of weeks (52 or 53) in the given retail year.
+func (cal RetailCalendar) WeeksInYear(year int) (int, error) {
+	if err := cal.validate(); err != nil {
+		return 0, err
+	}
+	return (cal.yearStart(year+1) - cal.yearStart(year)) / 7, nil
+}
+
+// HasWeek53 returns true when the given retail year is a "long" year that contains 53 weeks.
+func (cal RetailCalendar) HasWeek53(year int) (bool, error) {
+	weeks, err := cal.WeeksInYear(year)
+	return weeks == 53, err
+}
+
+// ParseRetailPeriod accepts a retail period string (e.g. "2019-P03") and returns the retail year
+// and period number (1-12) that it represents.
+func (cal RetailCalendar) ParseRetailPeriod(input string) (year int, period int, err error) {
+	if err = checkLength("YYYY-P##", input, 8); err != nil {
+		return 0, 0, err
+	}
+	if err = checkLiteral("YYYY-P##", input, 4, "-P"); err != nil {
+		return 0, 0, err
+	}
+	year, err = parseYear("YYYY-P##", input, 0, 4)
+	if err != nil {
+		return 0, 0, err
+	}
+	period, err = parsePeriod("YYYY-P##", input, 6, 8)
+	if err != nil {
+		return 0, 0, err
+	}
+	return year, period, nil
+}
+
+// ParseRetailPeriodStart returns midnight on the first day of the retail period string (e.g. "2019-P03").
+// The resulting date/time will be in UTC.
+func (cal RetailCalendar) ParseRetailPeriodStart(input string) (time.Time, error) {
+	return cal.ParseRetailPeriodStartIn(input, time.UTC)
+}
+
+// ParseRetailPeriodStartIn returns midnight on the first day of the retail period string (e.g. "2019-P03").
+// The resulting date/time will be in the specified time zone.
+func (cal RetailCalendar) ParseRetailPeriodStartIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse retail period start: %w", ErrNilLocation)
+	}
+	first, _, err := cal.periodDays(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	year, month, day := isoweek.JulianToDate(first)
+	return Midnight(year, month, day, loc), nil
+}
+
+// ParseRetailPeriodEnd returns 11:59:59pm on the last day of the retail period string (e.g. "2019-P03").
+// The resulting date/time will be in UTC.
+func (cal RetailCalendar) ParseRetailPeriodEnd(input string) (time.Time, error) {
+	return cal.ParseRetailPeriodEndIn(input, time.UTC)
+}
+
+// ParseRetailPeriodEndIn returns 11:59:59pm on the last day of the retail period string (e.g. "2019-P03").
+// The resulting date/time will be in the specified time zone.
+func (cal RetailCalendar) ParseRetailPeriodEndIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse retail period end: %w", ErrNilLocation)
+	}
+	_, last, err := cal.periodDays(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	year, month, day := isoweek.JulianToDate(last)
+	return AlmostMidnight(year, month, day, loc), nil
+}
+
+// ParseRetailPeriodEndExclusive returns midnight on the first day of the period after the retail
+// period string (e.g. "2019-P03"). The resulting date/time will be in UTC.
+func (cal RetailCalendar) ParseRetailPeriodEndExclusive(input string) (time.Time, error) {
+	return cal.ParseRetailPeriodEndExclusiveIn(input, time.UTC)
+}
+
+// ParseRetailPeriodEndExclusiveIn returns midnight on the first day of the period after the retail
+// period string (e.g. "2019-P03"). The resulting date/time will be in the specified time zone.
+func (cal RetailCalendar) ParseRetailPeriodEndExclusiveIn(input string, loc *time.Location) (time.Time, error) {
+	return exclusiveEnd(cal.ParseRetailPeriodEndIn(input, loc))
+}
+
+// ParseRetailPeriodRange returns the range from midnight on the first day through 11:59:59pm on the
+// last day of the retail period string (e.g. "2019-P03"). The resulting range will be in UTC.
+func (cal RetailCalendar) ParseRetailPeriodRange(input string) (Range, error) {
+	return cal.ParseRetailPeriodRangeIn(input, time.UTC)
+}
+
+// ParseRetailPeriodRangeIn returns the range from midnight on the first day through 11:59:59pm on the
+// last day of the retail period string (e.g. "2019-P03"). The resulting range will be in the specified
+// time zone.
+func (cal RetailCalendar) ParseRetailPeriodRangeIn(input string, loc *time.Location) (Range, error) {
+	if loc == nil {
+		return Range{}, fmt.Errorf("parse retail period range: %w", ErrNilLocation)
+	}
+	first, last, err := cal.periodDays(input)
+	if err != nil {
+		return Range{}, err
+	}
+	startYear, startMonth, startDay := isoweek.JulianToDate(first)
+	endYear, endMonth, endDay := isoweek.JulianToDate(last)
+	return Range{
+		Start: Midnight(startYear, startMonth, startDay, loc),
+		End:   AlmostMidnight(endYear, endMonth, endDay, loc),
+	}, nil
+}
+
+// ParseRetailWeek accepts a retail week string (e.g. "2019-W05") and returns the retail year and
+// the week number within that year. Week 53 is only valid in long years.
+func (cal RetailCalendar) ParseRetailWeek(input string) (year int, week int, err error) {
+	year, week, err = parseWeekFormat(input)
+	if err != nil {
+		return 0, 0, err
+	}
+	weeksInYear, err := cal.WeeksInYear(year)
+	if err != nil {
+		return 0, 0, err
+	}
+	if week > weeksInYear {
+		detail := fmt.Sprintf("%d has %d retail weeks", year, weeksInYear)
+		format := weekLayout(input).format
+		return 0, 0, nonexistentError(format, input, ComponentWeek, weekStart(input), weekStart(input)+2, detail)
+	}
+	return year, week, nil
+}
+
+// ParseRetailWeekStart returns midnight on the first day of the retail week string (e.g. "2019-W05").
+// The resulting date/time will be in UTC.
+func (cal RetailCalendar) ParseRetailWeekStart(input string) (time.Time, error) {
+	return cal.ParseRetailWeekStartIn(input, time.UTC)
+}
+
+// ParseRetailWeekStartIn returns midnight on the first day of the retail week string (e.g. "2019-W05").
+// The resulting date/time will be in the specified time zone.
+func (cal RetailCalendar) ParseRetailWeekStartIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse retail week start: %w", ErrNilLocation)
+	}
+	retailYear, week, err := cal.ParseRetailWeek(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	year, month, day := isoweek.JulianToDate(cal.yearStart(retailYear) + (week-1)*7)
+	return Midnight(year, month, day, loc), nil
+}
+
+// ParseRetailWeekEnd returns 11:59:59pm on the last day of the retail week string (e.g. "2019-W05").
+// The resulting date/time will be in UTC.
+func (cal RetailCalendar) ParseRetailWeekEnd(input string) (time.Time, error) {
+	return cal.ParseRetailWeekEndIn(input, time.UTC)
+}
+
+// ParseRetailWeekEndIn returns 11:59:59pm on the last day of the retail week string (e.g. "2019-W05").
+// The resulting date/time will be in the specified time zone.
+func (cal RetailCalendar) ParseRetailWeekEndIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse retail week end: %w", ErrNilLocation)
+	}
+	retailYear, week, err := cal.ParseRetailWeek(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	year, month, day := isoweek.JulianToDate(cal.yearStart(retailYear) + (week-1)*7 + 6)
+	return AlmostMidnight(year, month, day, loc), nil
+}
+
+// ParseRetailWeekEndExclusive returns midnight on the first day of the week after the retail week
+// string (e.g. "2019-W05"). The resulting date/time will be in UTC.
+func (cal RetailCalendar) ParseRetailWeekEndExclusive(input string) (time.Time, error) {
+	return cal.ParseRetailWeekEndExclusiveIn(input, time.UTC)
+}
+
+// ParseRetailWeekEndExclusiveIn returns midnight on the first day of the week after the retail week
+// string (e.g. "2019-W05"). The resulting date/time will be in the specified time zone.
+func (cal RetailCalendar) ParseRetailWeekEndExclusiveIn(input string, loc *time.Location) (time.Time, error) {
+	return exclusiveEnd(cal.ParseRetailWeekEndIn(input, loc))
+}
+
+// ParseRetailWeekRange returns the range from midnight on the first day through 11:59:59pm on the
+// last day of the retail week string (e.g. "2019-W05"). The resulting range will be in UTC.
+func (cal RetailCalendar) ParseRetailWeekRange(input string) (Range, error) {
+	return cal.ParseRetailWeekRangeIn(input, time.UTC)
+}
+
+// ParseRetailWeekRangeIn returns the range from midnight on the first day through 11:59:59pm on the
+// last day of the retail week string (e.g. "2019-W05"). The resulting range will be in the specified
+// time zone.
+func (cal RetailCalendar) ParseRetailWeekRangeIn(input string, loc *time.Location) (Range, error) {
+	if loc == nil {
+		return Range{}, fmt.Errorf("parse retail week range: %w", ErrNilLocation)
+	}
+	retailYear, week, err := cal.ParseRetailWeek(input)
+	if err != nil {
+		return Range{}, err
+	}
+	year, month, day := isoweek.JulianToDate(cal.yearStart(retailYear) + (week-1)*7)
+	return Range{Start: Midnight(year, month, day, loc), End: AlmostMidnight(year, month, day+6, loc)}, nil
+}
+
+// RetailPeriod returns the retail year, period (1-12), and week within the year (1-53) that the
+// given date/time falls in. The calendar date is taken from the date/time in its own location, so
+// convert it using time.In() first if you want the retail period for some other time zone.
+func (cal RetailCalendar) RetailPeriod(date time.Time) (year int, period int, week int, err error) {
+	if err = cal.validate(); err != nil {
+		return 0, 0, 0, err
+	}
+
+	dayNo := isoweek.DateToJulian(date.Date())
+	year = date.Year()
+	for dayNo < cal.yearStart(year) {
+		year--
+	}
+	for dayNo >= cal.yearStart(year+1) {
+		year++
+	}
+
+	week = (dayNo-cal.yearStart(year))/7 + 1
+	weeksSoFar := 0
+	for period = 1; period < 12; period++ {
+		weeksSoFar += cal.weeksInPeriod(period)
+		if week <= weeksSoFar {
+			break
+		}
+	}
+	return year, period, week, nil
+}
+
+// periodDays parses the retail period string and returns the Julian day numbers of the first
+// and last days in that period.
+func (cal RetailCalendar) periodDays(input string) (first int, last int, err error) {
+	year, period, err := cal.ParseRetailPeriod(input)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err = cal.validate(); err != nil {
+		return 0, 0, err
+	}
+
+	weeksBefore := 0
+	for p := 1; p < period; p++ {
+		weeksBefore += cal.weeksInPeriod(p)
+	}
+	weeks := cal.weeksInPeriod(period)
+	if period == 12 {
+		// The last period absorbs the 53rd week in long years.
+		weeks = (cal.yearStart(year+1)-cal.yearStart(year))/7 - weeksBefore
+	}
+
+	first = cal.yearStart(year) + weeksBefore*7
+	return first, first + weeks*7 - 1, nil
+}
+
+// weeksInPeriod returns the number of weeks in the given period of a 52-week year.
+func (cal RetailCalendar) weeksInPeriod(period int) int {
+	position := (period - 1) % 3
+	switch {
+	case cal.Pattern == Pattern445 && position == 2:
+		return 5
+	case cal.Pattern == Pattern454 && position == 1:
+		return 5
+	case cal.Pattern == Pattern544 && position == 0:
+		return 5
+	default:
+		return 4
+	}
+}
+
+// yearStart returns the Julian day number of the first day of the given retail year. This is the
+// calendar's start weekday that is nearest to the anchor date; the same way an ISO year begins on
+// the Monday nearest to January 1st.
+func (cal RetailCalendar) yearStart(year int) int {
+	anchor := isoweek.DateToJulian(year, cal.AnchorMonth, cal.AnchorDay)
+
+	// Both of these are ISO weekday numbers (1=Monday ... 7=Sunday).
+	anchorWeekday := isoweek.ISOWeekday(year, cal.AnchorMonth, cal.AnchorDay)
+	startWeekday := int(cal.StartWeekday)
+	if startWeekday == 0 {
+		startWeekday = 7
+	}
+
+	daysSinceStart := (anchorWeekday - startWeekday + 7) % 7
+	if daysSinceStart <= 3 {
+		return anchor - daysSinceStart
+	}
+	return anchor + 7 - daysSinceStart
+}
+
+func (cal RetailCalendar) validate() error {
+	switch {
+	case cal.Pattern < Pattern445 || cal.Pattern > Pattern544:
+		return fmt.Errorf("invalid retail pattern: %d", cal.Pattern)
+	case cal.StartWeekday < time.Sunday || cal.StartWeekday > time.Saturday:
+		return fmt.Errorf("invalid retail start weekday: %d", cal.StartWeekday)
+	case cal.AnchorMonth < time.January || cal.AnchorMonth > time.December:
+		return fmt.Errorf("invalid retail anchor month: %d", cal.AnchorMonth)
+	case cal.AnchorDay < 1 || cal.AnchorDay > Midnight(2001, cal.AnchorMonth+1, 0, time.UTC).Day():
+		// The anchor must exist in every year, so Feb 29 is not allowed.
+		return fmt.Errorf("invalid retail anchor day: %d", cal.AnchorDay)
+	}
+	return nil
+}
@@ -0,0 +1,359 @@
+package isodates_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestRetailSuite(t *testing.T) {
+	suite.Run(t, new(RetailSuite))
+}
+
+type RetailSuite struct {
+	ChronoSuite
+}
+
+// retail445 is a 4-4-5 calendar whose years start on the Monday nearest Jan 1, so its weeks are ISO weeks.
+var retail445 = isodates.RetailCalendar{
+	Pattern:      isodates.Pattern445,
+	StartWeekday: time.Monday,
+	AnchorMonth:  time.January,
+	AnchorDay:    1,
+}
+
+func (suite *RetailSuite) TestWeeksInYear() {
+	succeeds := func(cal isodates.RetailCalendar, year int, expected int) {
+		weeks, err := cal.WeeksInYear(year)
+		hasWeek53, _ := cal.HasWeek53(year)
+		_ = suite.NoError(err) &&
+			suite.Equal(expected, weeks) &&
+			suite.Equal(expected == 53, hasWeek53)
+	}
+	fails := func(cal isodates.RetailCalendar) {
+		_, err := cal.WeeksInYear(2019)
+		suite.Error(err)
+		_, err = cal.HasWeek53(2019)
+		suite.Error(err)
+	}
+	fails(isodates.RetailCalendar{})
+	fails(isodates.RetailCalendar{Pattern: 7, AnchorMonth: time.January, AnchorDay: 1})
+	fails(isodates.RetailCalendar{StartWeekday: 7, AnchorMonth: time.January, AnchorDay: 1})
+	fails(isodates.RetailCalendar{AnchorMonth: time.January})
+	fails(isodates.RetailCalendar{AnchorMonth: time.February, AnchorDay: 29})
+	fails(isodates.RetailCalendar{AnchorMonth: time.April, AnchorDay: 31})
+
+	succeeds(isodates.NRFRetailCalendar, 2011, 52)
+	succeeds(isodates.NRFRetailCalendar, 2012, 53)
+	succeeds(isodates.NRFRetailCalendar, 2017, 53)
+	succeeds(isodates.NRFRetailCalendar, 2019, 52)
+	succeeds(isodates.NRFRetailCalendar, 2023, 53)
+
+	// Same as ISO long years
+	succeeds(retail445, 2004, 53)
+	succeeds(retail445, 2015, 53)
+	succeeds(retail445, 2019, 52)
+	succeeds(retail445, 2020, 53)
+}
+
+func (suite *RetailSuite) TestParseRetailPeriod() {
+	succeeds := func(input string, expectedYear int, expectedPeriod int) {
+		year, period, err := isodates.NRFRetailCalendar.ParseRetailPeriod(input)
+		_ = suite.NoError(err) &&
+			suite.Equal(expectedYear, year) &&
+			suite.Equal(expectedPeriod, period)
+	}
+	fails := func(input string) {
+		_, _, err := isodates.NRFRetailCalendar.ParseRetailPeriod(input)
+		suite.Error(err)
+	}
+	fails("")
+	fails("not valid")
+	fails("2019-P")
+	fails("2019-P1")
+	fails("2019-P00")
+	fails("2019-P13")
+	fails("2019-PXX")
+	fails("2019/P01")
+	fails("219-P01")
+	fails("XXXX-P01")
+	fails("+019-P01")
+	fails("-019-P01")
+
+	succeeds("2019-P01", 2019, 1)
+	succeeds("2019-P12", 2019, 12)
+	succeeds("0001-P05", 1, 5)
+}
+
+func (suite *RetailSuite) TestParseRetailPeriodStart() {
+	succeeds := func(cal isodates.RetailCalendar, input string, year int, month time.Month, day int) {
+		date, err := cal.ParseRetailPeriodStart(input)
+		suite.AssertMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(cal isodates.RetailCalendar, input string) {
+		_, err := cal.ParseRetailPeriodStart(input)
+		suite.Error(err)
+	}
+	fails(isodates.NRFRetailCalendar, "")
+	fails(isodates.NRFRetailCalendar, "2019-P13")
+	fails(isodates.RetailCalendar{}, "2019-P01")
+
+	// 4-5-4: Feb has 4 weeks, Mar has 5, Apr has 4, ...
+	succeeds(isodates.NRFRetailCalendar, "2019-P01", 2019, time.February, 3)
+	succeeds(isodates.NRFRetailCalendar, "2019-P02", 2019, time.March, 3)
+	succeeds(isodates.NRFRetailCalendar, "2019-P03", 2019, time.April, 7)
+	succeeds(isodates.NRFRetailCalendar, "2019-P04", 2019, time.May, 5)
+	succeeds(isodates.NRFRetailCalendar, "2019-P12", 2020, time.January, 5)
+
+	succeeds(retail445, "2019-P01", 2018, time.December, 31)
+	succeeds(retail445, "2019-P02", 2019, time.January, 28)
+	succeeds(retail445, "2019-P03", 2019, time.February, 25)
+	succeeds(retail445, "2019-P04", 2019, time.April, 1)
+
+	pattern544 := retail445
+	pattern544.Pattern = isodates.Pattern544
+	succeeds(pattern544, "2019-P02", 2019, time.February, 4)
+	succeeds(pattern544, "2019-P03", 2019, time.March, 4)
+	succeeds(pattern544, "2019-P04", 2019, time.April, 1)
+}
+
+func (suite *RetailSuite) TestParseRetailPeriodStartIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := isodates.NRFRetailCalendar.ParseRetailPeriodStartIn(input, loc)
+		suite.AssertMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.NRFRetailCalendar.ParseRetailPeriodStartIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", locationEDT)
+	fails("2019-P01", nil)
+
+	succeeds("2019-P01", locationEDT, 2019, time.February, 3)
+	succeeds("2019-P01", locationPDT, 2019, time.February, 3)
+	succeeds("2019-P12", locationEDT, 2020, time.January, 5)
+}
+
+func (suite *RetailSuite) TestParseRetailPeriodEnd() {
+	succeeds := func(cal isodates.RetailCalendar, input string, year int, month time.Month, day int) {
+		date, err := cal.ParseRetailPeriodEnd(input)
+		suite.AssertAlmostMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(cal isodates.RetailCalendar, input string) {
+		_, err := cal.ParseRetailPeriodEnd(input)
+		suite.Error(err)
+	}
+	fails(isodates.NRFRetailCalendar, "")
+	fails(isodates.NRFRetailCalendar, "2019-P13")
+	fails(isodates.RetailCalendar{}, "2019-P01")
+
+	succeeds(isodates.NRFRetailCalendar, "2019-P01", 2019, time.March, 2)
+	succeeds(isodates.NRFRetailCalendar, "2019-P02", 2019, time.April, 6)
+	succeeds(isodates.NRFRetailCalendar, "2019-P12", 2020, time.February, 1)
+
+	// The 53rd week is tacked on to the last period.
+	succeeds(isodates.NRFRetailCalendar, "2017-P11", 2017, time.December, 30)
+	succeeds(isodates.NRFRetailCalendar, "2017-P12", 2018, time.February, 3)
+
+	succeeds(retail445, "2019-P03", 2019, time.March, 31)
+	succeeds(retail445, "2020-P12", 2021, time.January, 3)
+}
+
+func (suite *RetailSuite) TestParseRetailPeriodEndIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := isodates.NRFRetailCalendar.ParseRetailPeriodEndIn(input, loc)
+		suite.AssertAlmostMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.NRFRetailCalendar.ParseRetailPeriodEndIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", locationEDT)
+	fails("2019-P01", nil)
+
+	succeeds("2019-P01", locationEDT, 2019, time.March, 2)
+	succeeds("2019-P01", locationPDT, 2019, time.March, 2)
+	succeeds("2017-P12", locationEDT, 2018, time.February, 3)
+}
+
+func (suite *RetailSuite) TestParseRetailWeek() {
+	succeeds := func(input string, expectedYear int, expectedWeek int) {
+		year, week, err := isodates.NRFRetailCalendar.ParseRetailWeek(input)
+		_ = suite.NoError(err) &&
+			suite.Equal(expectedYear, year) &&
+			suite.Equal(expectedWeek, week)
+	}
+	fails := func(input string) {
+		_, _, err := isodates.NRFRetailCalendar.ParseRetailWeek(input)
+		suite.Error(err)
+	}
+	fails("")
+	fails("not valid")
+	fails("2019-W")
+	fails("2019-W1")
+	fails("2019-W00")
+	fails("2019-W54")
+	fails("2019-W53") // 2019 is a short year
+	fails("2019/W01")
+	fails("XXXX-W01")
+
+	succeeds("2019-W01", 2019, 1)
+	succeeds("2019-W52", 2019, 52)
+	succeeds("2017-W53", 2017, 53)
+}
+
+func (suite *RetailSuite) TestParseRetailWeekStart() {
+	succeeds := func(input string, year int, month time.Month, day int) {
+		date, err := isodates.NRFRetailCalendar.ParseRetailWeekStart(input)
+		suite.AssertMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(input string) {
+		_, err := isodates.NRFRetailCalendar.ParseRetailWeekStart(input)
+		suite.Error(err)
+	}
+	fails("")
+	fails("2019-W53")
+
+	succeeds("2019-W01", 2019, time.February, 3)
+	succeeds("2019-W02", 2019, time.February, 10)
+	succeeds("2019-W52", 2020, time.January, 26)
+	succeeds("2017-W53", 2018, time.January, 28)
+}
+
+func (suite *RetailSuite) TestParseRetailWeekStartIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := isodates.NRFRetailCalendar.ParseRetailWeekStartIn(input, loc)
+		suite.AssertMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.NRFRetailCalendar.ParseRetailWeekStartIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", locationEDT)
+	fails("2019-W01", nil)
+
+	succeeds("2019-W01", locationEDT, 2019, time.February, 3)
+	succeeds("2019-W01", locationPDT, 2019, time.February, 3)
+}
+
+func (suite *RetailSuite) TestParseRetailWeekEnd() {
+	succeeds := func(input string, year int, month time.Month, day int) {
+		date, err := isodates.NRFRetailCalendar.ParseRetailWeekEnd(input)
+		suite.AssertAlmostMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(input string) {
+		_, err := isodates.NRFRetailCalendar.ParseRetailWeekEnd(input)
+		suite.Error(err)
+	}
+	fails("")
+	fails("2019-W53")
+
+	succeeds("2019-W01", 2019, time.February, 9)
+	succeeds("2019-W52", 2020, time.February, 1)
+	succeeds("2017-W53", 2018, time.February, 3)
+}
+
+func (suite *RetailSuite) TestParseRetailWeekEndIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := isodates.NRFRetailCalendar.ParseRetailWeekEndIn(input, loc)
+		suite.AssertAlmostMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.NRFRetailCalendar.ParseRetailWeekEndIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", locationEDT)
+	fails("2019-W01", nil)
+
+	succeeds("2019-W01", locationEDT, 2019, time.February, 9)
+	succeeds("2019-W01", locationPDT, 2019, time.February, 9)
+}
+
+func (suite *RetailSuite) TestParseRetailEndExclusiveIn() {
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.NRFRetailCalendar.ParseRetailPeriodEndExclusiveIn(input, loc)
+		suite.Error(err)
+		_, err = isodates.NRFRetailCalendar.ParseRetailWeekEndExclusiveIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", locationEDT)
+	fails("2019-P01", nil)
+
+	date, err := isodates.NRFRetailCalendar.ParseRetailPeriodEndExclusiveIn("2019-P01", locationEDT)
+	suite.AssertMidnightIn(date, err, 2019, time.March, 3, locationEDT)
+
+	date, err = isodates.NRFRetailCalendar.ParseRetailPeriodEndExclusive("2019-P12")
+	suite.AssertMidnightUTC(date, err, 2020, time.February, 2)
+
+	date, err = isodates.NRFRetailCalendar.ParseRetailWeekEndExclusiveIn("2019-W01", locationPDT)
+	suite.AssertMidnightIn(date, err, 2019, time.February, 10, locationPDT)
+
+	date, err = isodates.NRFRetailCalendar.ParseRetailWeekEndExclusive("2019-W52")
+	suite.AssertMidnightUTC(date, err, 2020, time.February, 2)
+}
+
+func (suite *RetailSuite) TestParseRetailRangeIn() {
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.NRFRetailCalendar.ParseRetailPeriodRangeIn(input, loc)
+		suite.Error(err)
+		_, err = isodates.NRFRetailCalendar.ParseRetailWeekRangeIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", locationEDT)
+	fails("2019-P01", nil)
+	fails("2019-W01", nil)
+
+	r, err := isodates.NRFRetailCalendar.ParseRetailPeriodRangeIn("2019-P01", locationEDT)
+	suite.AssertRangeIn(r, err, 2019, time.February, 3, 2019, time.March, 2, locationEDT)
+
+	r, err = isodates.NRFRetailCalendar.ParseRetailPeriodRange("2019-P12")
+	suite.AssertRangeIn(r, err, 2020, time.January, 5, 2020, time.February, 1, time.UTC)
+
+	r, err = isodates.NRFRetailCalendar.ParseRetailWeekRangeIn("2019-W01", locationPDT)
+	suite.AssertRangeIn(r, err, 2019, time.February, 3, 2019, time.February, 9, locationPDT)
+
+	r, err = isodates.NRFRetailCalendar.ParseRetailWeekRange("2019-W52")
+	suite.AssertRangeIn(r, err, 2020, time.January, 26, 2020, time.February, 1, time.UTC)
+}
+
+func (suite *RetailSuite) TestRetailPeriod() {
+	succeeds := func(cal isodates.RetailCalendar, date time.Time, expectedYear, expectedPeriod, expectedWeek int) {
+		year, period, week, err := cal.RetailPeriod(date)
+		_ = suite.NoError(err) &&
+			suite.Equal(expectedYear, year, "incorrect year") &&
+			suite.Equal(expectedPeriod, period, "incorrect period") &&
+			suite.Equal(expectedWeek, week, "incorrect week")
+	}
+	fails := func(cal isodates.RetailCalendar, date time.Time) {
+		_, _, _, err := cal.RetailPeriod(date)
+		suite.Error(err)
+	}
+	fails(isodates.RetailCalendar{}, time.Now())
+
+	nrf := isodates.NRFRetailCalendar
+	succeeds(nrf, time.Date(2019, time.February, 2, 12, 0, 0, 0, time.UTC), 2018, 12, 52)
+	succeeds(nrf, time.Date(2019, time.February, 3, 0, 0, 0, 0, time.UTC), 2019, 1, 1)
+	succeeds(nrf, time.Date(2019, time.March, 2, 0, 0, 0, 0, time.UTC), 2019, 1, 4)
+	succeeds(nrf, time.Date(2019, time.March, 3, 0, 0, 0, 0, time.UTC), 2019, 2, 5)
+	succeeds(nrf, time.Date(2019, time.April, 6, 0, 0, 0, 0, time.UTC), 2019, 2, 9)
+	succeeds(nrf, time.Date(2019, time.April, 7, 0, 0, 0, 0, time.UTC), 2019, 3, 10)
+	succeeds(nrf, time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC), 2019, 11, 48)
+	succeeds(nrf, time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC), 2019, 12, 52)
+	succeeds(nrf, time.Date(2018, time.February, 3, 0, 0, 0, 0, time.UTC), 2017, 12, 53)
+	succeeds(nrf, time.Date(2018, time.February, 4, 0, 0, 0, 0, time.UTC), 2018, 1, 1)
+
+	succeeds(retail445, time.Date(2018, time.December, 31, 0, 0, 0, 0, time.UTC), 2019, 1, 1)
+	succeeds(retail445, time.Date(2019, time.March, 31, 0, 0, 0, 0, time.UTC), 2019, 3, 13)
+	succeeds(retail445, time.Date(2021, time.January, 3, 0, 0, 0, 0, time.UTC), 2020, 12, 53)
+}
+
+func ExampleRetailCalendar_ParseRetailPeriodStart() {
+	start, _ := isodates.NRFRetailCalendar.ParseRetailPeriodStart("2019-P03")
+	end, _ := isodates.NRFRetailCalendar.ParseRetailPeriodEnd("2019-P03")
+	fmt.Println(start.Format("Jan 2, 2006") + " - " + end.Format("Jan 2, 2006"))
+
+	// Output: Apr 7, 2019 - May 4, 2019
+}
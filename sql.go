@@ -0,0 +1,265 @@
+package isodates
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"time"
+)
+
+/*
+ * Every value type implements sql.Scanner and driver.Valuer so that you can use them directly in
+ * your queries and as fields that you scan into. Dates always map to DATE columns. Weeks, week/days,
+ * and year/months are stored according to SQLStorage, but they can be scanned from either kind of
+ * column. Month/days don't have a year, so they're always stored as text, and durations are stored
+ * as their ISO text. Zero values are stored as NULL and NULL is scanned as the zero value.
+ */
+
+// SQLStorageMode determines what kind of column the Week, WeekDay, and YearMonth types are stored in.
+type SQLStorageMode int
+
+const (
+	// SQLText stores values as their canonical ISO strings (e.g. "2019-W05") in a text column.
+	SQLText SQLStorageMode = iota
+	// SQLStartDate stores values as the date of their first day (e.g. 2019-01-28 for "2019-W05") in a
+	// DATE column. The driver receives a time.Time at midnight UTC.
+	SQLStartDate
+)
+
+// SQLStorage determines how Week, WeekDay, and YearMonth values are written to the database. Set it
+// once at startup to match your schema. The default is SQLText. Scanning works with either kind of
+// column regardless of this setting; a DATE is scanned as the week/month that contains it.
+var SQLStorage = SQLText
+
+// Value stores the date as a time.Time at midnight UTC, so it maps to a DATE column.
+func (date Date) Value() (driver.Value, error) {
+	if date.IsZero() {
+		return nil, nil
+	}
+	return Midnight(date.Year, date.Month, date.Day, time.UTC), nil
+}
+
+// Scan reads the date from a DATE column (a time.Time) or from text such as "2019-05-22".
+func (date *Date) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		*date = Date{}
+		return nil
+	case time.Time:
+		year, month, day := src.Date()
+		*date = Date{Year: year, Month: month, Day: day}
+		return nil
+	}
+	input, err := scanText("date", src)
+	if err != nil {
+		return err
+	}
+	value, err := ParseDateValue(input)
+	if err != nil {
+		return err
+	}
+	*date = value
+	return nil
+}
+
+// Value stores the week as either its ISO string or the date of its Monday, depending on SQLStorage.
+func (week Week) Value() (driver.Value, error) {
+	if week.IsZero() {
+		return nil, nil
+	}
+	return sqlValue(week, week.Start(time.UTC)), nil
+}
+
+// Scan reads the week from text such as "2019-W05" or from a DATE column (a time.Time), in which
+// case you get the ISO week containing that date.
+func (week *Week) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		*week = Week{}
+		return nil
+	case time.Time:
+		year, num := ISOWeeks.FromDate(src.Date())
+		*week = Week{Year: year, Week: num}
+		return nil
+	}
+	input, err := scanText("week", src)
+	if err != nil {
+		return err
+	}
+	value, err := ParseWeekValue(input)
+	if err != nil {
+		return err
+	}
+	*week = value
+	return nil
+}
+
+// Value stores the week/day as either its ISO string or its date, depending on SQLStorage.
+func (weekDay WeekDay) Value() (driver.Value, error) {
+	if weekDay.IsZero() {
+		return nil, nil
+	}
+	return sqlValue(weekDay, weekDay.Start(time.UTC)), nil
+}
+
+// Scan reads the week/day from text such as "2019-W05-3" or from a DATE column (a time.Time).
+func (weekDay *WeekDay) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		*weekDay = WeekDay{}
+		return nil
+	case time.Time:
+		year, week := ISOWeeks.FromDate(src.Date())
+		*weekDay = WeekDay{Year: year, Week: week, Day: isoWeekday(src.Weekday())}
+		return nil
+	}
+	input, err := scanText("week day", src)
+	if err != nil {
+		return err
+	}
+	value, err := ParseWeekDayValue(input)
+	if err != nil {
+		return err
+	}
+	*weekDay = value
+	return nil
+}
+
+// Value stores the year/month as either its ISO string or the date of its first day, depending on SQLStorage.
+func (yearMonth YearMonth) Value() (driver.Value, error) {
+	if yearMonth.IsZero() {
+		return nil, nil
+	}
+	return sqlValue(yearMonth, yearMonth.Start(time.UTC)), nil
+}
+
+// Scan reads the year/month from text such as "2019-05" or from a DATE column (a time.Time), in which
+// case you get the month containing that date.
+func (yearMonth *YearMonth) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case nil:
+		*yearMonth = YearMonth{}
+		return nil
+	case time.Time:
+		*yearMonth = YearMonth{Year: src.Year(), Month: src.Month()}
+		return nil
+	}
+	input, err := scanText("year month", src)
+	if err != nil {
+		return err
+	}
+	value, err := ParseYearMonthValue(input)
+	if err != nil {
+		return err
+	}
+	*yearMonth = value
+	return nil
+}
+
+// Value stores the month/day as its ISO string (e.g. "--05-22"). There's no SQL type for a date without
+// a year, so this ignores SQLStorage.
+func (monthDay MonthDay) Value() (driver.Value, error) {
+	if monthDay.IsZero() {
+		return nil, nil
+	}
+	return monthDay.String(), nil
+}
+
+// Scan reads the month/day from text such as "--05-22".
+func (monthDay *MonthDay) Scan(src interface{}) error {
+	if src == nil {
+		*monthDay = MonthDay{}
+		return nil
+	}
+	input, err := scanText("month day", src)
+	if err != nil {
+		return err
+	}
+	value, err := ParseMonthDayValue(input)
+	if err != nil {
+		return err
+	}
+	*monthDay = value
+	return nil
+}
+
+// Value stores the duration as its ISO string (e.g. "P1DT12H"), which Postgres accepts as input for an
+// interval column. Durations that mix positive and negative components can't be written as ISO strings,
+// so they result in an error.
+func (d Duration) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	if err := d.checkSigns(); err != nil {
+		return nil, err
+	}
+	return d.String(), nil
+}
+
+// Scan reads the duration from text such as "P1DT12H". Postgres only returns intervals in that format
+// when the connection has "SET intervalstyle = 'iso_8601'", so make sure that you set that up.
+func (d *Duration) Scan(src interface{}) error {
+	if src == nil {
+		*d = Duration{}
+		return nil
+	}
+	input, err := scanText("duration", src)
+	if err != nil {
+		return err
+	}
+	value, err := ParseDuration(input)
+	if err != nil {
+		return err
+	}
+	*d = value
+	return nil
+}
+
+// Value stores the range as a Postgres range literal such as "[2019-05-22T00:00:00Z,2019-05-23T00:00:00Z)"
+// so it can go straight into a tstzrange column. Inclusive ranges are written as the equivalent half-open
+// range since Postgres rounds timestamps to microseconds, which would turn an inclusive end such as
+// 23:59:59.999999999 into midnight of the following day.
+func (r Range) Value() (driver.Value, error) {
+	if r.isZero() {
+		return nil, nil
+	}
+	return FormatRangeLiteral(r.HalfOpen()), nil
+}
+
+// Scan reads the range from a Postgres range literal such as `["2019-05-22 00:00:00+00","2019-05-23 00:00:00+00")`
+// or "[2019-05-22,2019-05-23)". See ParseRangeLiteral for the details.
+func (r *Range) Scan(src interface{}) error {
+	if src == nil {
+		*r = Range{}
+		return nil
+	}
+	input, err := scanText("range", src)
+	if err != nil {
+		return err
+	}
+	value, err := ParseRangeLiteral(input)
+	if err != nil {
+		return err
+	}
+	*r = value
+	return nil
+}
+
+// sqlValue returns either the ISO string or the start date of the value, depending on SQLStorage.
+func sqlValue(value fmt.Stringer, start time.Time) driver.Value {
+	if SQLStorage == SQLStartDate {
+		return start
+	}
+	return value.String()
+}
+
+// scanText converts the text/blob value that the driver gave us into a string.
+func scanText(name string, src interface{}) (string, error) {
+	switch src := src.(type) {
+	case string:
+		return src, nil
+	case []byte:
+		return string(src), nil
+	default:
+		return "", fmt.Errorf("scan %s: unsupported type %T", name, src)
+	}
+}
@@ -0,0 +1,264 @@
+package isodates_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSQLSuite(t *testing.T) {
+	suite.Run(t, new(SQLSuite))
+}
+
+type SQLSuite struct {
+	ChronoSuite
+	db *sql.DB
+}
+
+func (suite *SQLSuite) SetupTest() {
+	fakeDB.values = nil
+	suite.db = sql.OpenDB(fakeConnector{})
+}
+
+func (suite *SQLSuite) TearDownTest() {
+	isodates.SQLStorage = isodates.SQLText
+	_ = suite.db.Close()
+}
+
+// roundTrip writes the value through the fake driver, makes sure the driver received the expected value,
+// and then scans that value back into the target.
+func (suite *SQLSuite) roundTrip(value interface{}, expected driver.Value, target interface{}) bool {
+	if _, err := suite.db.Exec("INSERT", value); !suite.NoError(err) {
+		return false
+	}
+	if !suite.Equal(expected, fakeDB.values[0]) {
+		return false
+	}
+	return suite.NoError(suite.db.QueryRow("SELECT").Scan(target))
+}
+
+// scan has the fake driver return the raw value and scans it into the target.
+func (suite *SQLSuite) scan(raw driver.Value, target interface{}) error {
+	fakeDB.values = []driver.Value{raw}
+	return suite.db.QueryRow("SELECT").Scan(target)
+}
+
+func (suite *SQLSuite) TestDate() {
+	date := isodates.Date{Year: 2019, Month: time.May, Day: 22}
+	var actual isodates.Date
+	_ = suite.roundTrip(date, time.Date(2019, time.May, 22, 0, 0, 0, 0, time.UTC), &actual) &&
+		suite.Equal(date, actual)
+
+	suite.NoError(suite.scan("2019-05-22", &actual))
+	suite.Equal(date, actual)
+	suite.NoError(suite.scan([]byte("2020-02-29"), &actual))
+	suite.Equal(isodates.Date{Year: 2020, Month: time.February, Day: 29}, actual)
+
+	suite.Error(suite.scan("2019-02-29", &actual))
+	suite.Error(suite.scan(int64(20190522), &actual))
+}
+
+func (suite *SQLSuite) TestWeek() {
+	week := isodates.Week{Year: 2019, Week: 5}
+	var actual isodates.Week
+	_ = suite.roundTrip(week, "2019-W05", &actual) && suite.Equal(week, actual)
+
+	isodates.SQLStorage = isodates.SQLStartDate
+	_ = suite.roundTrip(week, time.Date(2019, time.January, 28, 0, 0, 0, 0, time.UTC), &actual) &&
+		suite.Equal(week, actual)
+
+	// Any day in the week works, even across the year boundary.
+	suite.NoError(suite.scan(time.Date(2021, time.January, 3, 0, 0, 0, 0, time.UTC), &actual))
+	suite.Equal(isodates.Week{Year: 2020, Week: 53}, actual)
+
+	var parseErr *isodates.ParseError
+	suite.True(errors.As(suite.scan("2019-W53", &actual), &parseErr))
+}
+
+func (suite *SQLSuite) TestWeekDay() {
+	weekDay := isodates.WeekDay{Year: 2020, Week: 53, Day: 7}
+	var actual isodates.WeekDay
+	_ = suite.roundTrip(weekDay, "2020-W53-7", &actual) && suite.Equal(weekDay, actual)
+
+	isodates.SQLStorage = isodates.SQLStartDate
+	_ = suite.roundTrip(weekDay, time.Date(2021, time.January, 3, 0, 0, 0, 0, time.UTC), &actual) &&
+		suite.Equal(weekDay, actual)
+
+	suite.Error(suite.scan("2019-W05-8", &actual))
+}
+
+func (suite *SQLSuite) TestYearMonth() {
+	yearMonth := isodates.YearMonth{Year: 2019, Month: time.May}
+	var actual isodates.YearMonth
+	_ = suite.roundTrip(yearMonth, "2019-05", &actual) && suite.Equal(yearMonth, actual)
+
+	isodates.SQLStorage = isodates.SQLStartDate
+	_ = suite.roundTrip(yearMonth, time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC), &actual) &&
+		suite.Equal(yearMonth, actual)
+
+	suite.NoError(suite.scan(time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC), &actual))
+	suite.Equal(isodates.YearMonth{Year: 2019, Month: time.December}, actual)
+	suite.Error(suite.scan("2019-13", &actual))
+}
+
+func (suite *SQLSuite) TestMonthDay() {
+	monthDay := isodates.MonthDay{Month: time.December, Day: 25}
+	var actual isodates.MonthDay
+	_ = suite.roundTrip(monthDay, "--12-25", &actual) && suite.Equal(monthDay, actual)
+
+	// There's no date column for a month/day, so the setting doesn't apply.
+	isodates.SQLStorage = isodates.SQLStartDate
+	_ = suite.roundTrip(monthDay, "--12-25", &actual) && suite.Equal(monthDay, actual)
+
+	suite.Error(suite.scan("--02-30", &actual))
+	suite.Error(suite.scan(time.Now(), &actual))
+}
+
+func (suite *SQLSuite) TestDuration() {
+	d := isodates.Duration{Days: 1, Clock: 12 * time.Hour}
+	var actual isodates.Duration
+	_ = suite.roundTrip(d, "P1DT12H", &actual) && suite.Equal(d, actual)
+
+	// This is what Postgres gives you for an interval with "intervalstyle = 'iso_8601'".
+	suite.NoError(suite.scan([]byte("P1Y2M3DT04H05M06S"), &actual))
+	suite.Equal(isodates.Duration{Years: 1, Months: 2, Days: 3, Clock: 4*time.Hour + 5*time.Minute + 6*time.Second}, actual)
+
+	suite.Error(suite.scan("1 day 12:00:00", &actual))
+	suite.Error(suite.scan(int64(86400), &actual))
+}
+
+func (suite *SQLSuite) TestRange() {
+	r := day(2019, time.May, 22)
+	halfOpen := r.HalfOpen()
+	var actual isodates.Range
+	_ = suite.roundTrip(halfOpen, "[2019-05-22T00:00:00Z,2019-05-23T00:00:00Z)", &actual) &&
+		suite.True(halfOpen.Equal(actual))
+
+	// Postgres only keeps microseconds, so an inclusive end of 23:59:59.999999999 would round up into
+	// the next day. Inclusive ranges are written as the equivalent half-open range instead.
+	_ = suite.roundTrip(r, "[2019-05-22T00:00:00Z,2019-05-23T00:00:00Z)", &actual) &&
+		suite.True(halfOpen.Equal(actual))
+	value, err := r.Value()
+	_ = suite.NoError(err) && suite.True(strings.HasSuffix(value.(string), ")"), "%v", value)
+
+	// This is what Postgres gives you for a tstzrange.
+	suite.NoError(suite.scan(`["2019-05-22 00:00:00+00","2019-05-23 00:00:00+00")`, &actual))
+	suite.True(halfOpen.Equal(actual))
+	suite.NoError(suite.scan([]byte(`["2019-05-22 00:00:00-04","2019-05-23 00:00:00-04")`), &actual))
+	suite.True(isodates.Range{
+		Start:     isodates.Midnight(2019, time.May, 22, locationEDT),
+		End:       isodates.Midnight(2019, time.May, 23, locationEDT),
+		Exclusive: true,
+	}.Equal(actual))
+
+	// This is what Postgres gives you for a daterange.
+	suite.NoError(suite.scan("[2019-05-22,2019-05-23)", &actual))
+	suite.True(halfOpen.Equal(actual))
+	suite.NoError(suite.scan("empty", &actual))
+	suite.Equal(isodates.Range{}, actual)
+
+	// Unbounded ranges are neither empty nor NULL.
+	suite.NoError(suite.scan("[-infinity,infinity]", &actual))
+	suite.Equal(isodates.Range{LowerUnbounded: true, UpperUnbounded: true}, actual)
+	suite.NoError(suite.scan(`["2019-05-22 00:00:00+00",)`, &actual))
+	suite.True(isodates.Range{Start: halfOpen.Start, UpperUnbounded: true}.Equal(actual))
+	_ = suite.roundTrip(isodates.Range{LowerUnbounded: true, UpperUnbounded: true}, "(,)", &actual) &&
+		suite.Equal(isodates.Range{LowerUnbounded: true, UpperUnbounded: true}, actual)
+	_ = suite.roundTrip(isodates.Range{Start: halfOpen.Start, UpperUnbounded: true}, "[2019-05-22T00:00:00Z,)", &actual) &&
+		suite.True(isodates.Range{Start: halfOpen.Start, UpperUnbounded: true}.Equal(actual))
+
+	suite.Error(suite.scan("", &actual))
+	suite.Error(suite.scan("[2019-05-22T00:00:00Z]", &actual))
+	suite.Error(suite.scan("2019-05-22T00:00:00Z,2019-05-23T00:00:00Z)", &actual))
+	suite.Error(suite.scan("[2019-05-22T00:00:00Z,2019-05-23T00:00:00Z", &actual))
+	suite.Error(suite.scan("[2019-05-22T00:00:00Z,nope)", &actual))
+}
+
+func (suite *SQLSuite) TestNull() {
+	_, err := suite.db.Exec("INSERT", isodates.Week{})
+	_ = suite.NoError(err) && suite.Nil(fakeDB.values[0])
+	_, err = suite.db.Exec("INSERT", isodates.Range{})
+	_ = suite.NoError(err) && suite.Nil(fakeDB.values[0])
+	_, err = suite.db.Exec("INSERT", isodates.Duration{})
+	_ = suite.NoError(err) && suite.Nil(fakeDB.values[0])
+
+	date := isodates.Date{Year: 2019, Month: time.May, Day: 22}
+	_ = suite.NoError(suite.scan(nil, &date)) && suite.True(date.IsZero())
+	week := isodates.Week{Year: 2019, Week: 5}
+	_ = suite.NoError(suite.scan(nil, &week)) && suite.True(week.IsZero())
+	r := day(2019, time.May, 22)
+	_ = suite.NoError(suite.scan(nil, &r)) && suite.Equal(isodates.Range{}, r)
+	d := isodates.Duration{Days: 1}
+	_ = suite.NoError(suite.scan(nil, &d)) && suite.True(d.IsZero())
+}
+
+/*
+ * A fake database driver that remembers the arguments of the last statement that was executed and
+ * returns them as the single row of any query. That's enough to see exactly what values the driver
+ * receives from a driver.Valuer and to feed raw driver values into a sql.Scanner.
+ */
+
+var fakeDB = struct {
+	sync.Mutex
+	values []driver.Value
+}{}
+
+type fakeConnector struct{}
+
+func (fakeConnector) Connect(context.Context) (driver.Conn, error) { return fakeConn{}, nil }
+func (fakeConnector) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) { return fakeStmt{}, nil }
+func (fakeConn) Close() error                              { return nil }
+func (fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+
+type fakeStmt struct{}
+
+func (fakeStmt) Close() error  { return nil }
+func (fakeStmt) NumInput() int { return -1 }
+
+func (fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	fakeDB.Lock()
+	defer fakeDB.Unlock()
+	fakeDB.values = args
+	return driver.RowsAffected(1), nil
+}
+
+func (fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	fakeDB.Lock()
+	defer fakeDB.Unlock()
+	return &fakeRows{values: fakeDB.values}, nil
+}
+
+type fakeRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (rows *fakeRows) Columns() []string {
+	return make([]string, len(rows.values))
+}
+
+func (rows *fakeRows) Close() error {
+	return nil
+}
+
+func (rows *fakeRows) Next(dest []driver.Value) error {
+	if rows.done {
+		return io.EOF
+	}
+	rows.done = true
+	copy(dest, rows.values)
+	return nil
+}
@@ -0,0 +1,212 @@
+package isodates
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+/*
+ * Suggestions are only computed when you ask for them, so the happy path (and the plain error
+ * path) never pays for any of this. We break the bad input down into its runs of digits/letters,
+ * ignoring whatever separators the user typed, and then try to put them back together in the
+ * format we were expecting. We only ever suggest something that actually parses.
+ */
+
+// Suggestion looks for common mistakes in the input (e.g. missing zero padding, swapped day and
+// month, '/' separators, a lowercase 'w', or a week/day given where a week was expected) and returns
+// the canonical version of the input that was most likely intended (e.g. "2019-W05" for "2019-W5").
+// It returns an empty string when there's nothing obvious to suggest.
+func (err *ParseError) Suggestion() string {
+	var suggestion string
+	tokens := tokenize(err.Input)
+
+	switch err.Format {
+	case "YYYY-MM-DD", "YYYYMMDD", "[+-]YYYY-MM-DD", "[+-]YYYYMMDD":
+		suggestion = suggestDate(tokens)
+	case "YYYY-W##", "YYYYW##", "[+-]YYYY-W##", "[+-]YYYYW##":
+		suggestion = suggestWeek(tokens)
+	case "YYYY-W##-#", "YYYYW###", "[+-]YYYY-W##-#", "[+-]YYYYW###":
+		suggestion = suggestWeekDay(tokens)
+	case "YYYY-MM", "[+-]YYYY-MM":
+		suggestion = suggestYearMonth(tokens)
+	case "--MM-DD":
+		suggestion = suggestMonthDay(tokens)
+	}
+
+	if suggestion == err.Input {
+		return ""
+	}
+	return suggestion
+}
+
+// Hint wraps the Suggestion() in a human-readable message such as "did you mean 2019-W05?" that you
+// can show directly to the user. It returns an empty string when there's nothing to suggest.
+func (err *ParseError) Hint() string {
+	suggestion := err.Suggestion()
+	if suggestion == "" {
+		return ""
+	}
+	return "did you mean " + suggestion + "?"
+}
+
+func suggestDate(tokens []string) string {
+	if len(tokens) != 3 || !allDigits(tokens...) {
+		return ""
+	}
+
+	var candidates []string
+	switch {
+	// "2019-5-3", "2019/05/03", or "2019-22-05" (swapped)
+	case len(tokens[0]) == 4:
+		candidates = []string{
+			tokens[0] + "-" + pad(tokens[1], 2) + "-" + pad(tokens[2], 2),
+			tokens[0] + "-" + pad(tokens[2], 2) + "-" + pad(tokens[1], 2),
+		}
+	// "22/05/2019" or "05/22/2019"; only the ones that can't be read both ways will parse below.
+	case len(tokens[2]) == 4 && atoi(tokens[0]) > 12:
+		candidates = []string{tokens[2] + "-" + pad(tokens[1], 2) + "-" + pad(tokens[0], 2)}
+	case len(tokens[2]) == 4 && atoi(tokens[1]) > 12:
+		candidates = []string{tokens[2] + "-" + pad(tokens[0], 2) + "-" + pad(tokens[1], 2)}
+	}
+
+	for _, candidate := range candidates {
+		if _, _, _, err := ParseDate(candidate); err == nil {
+			return candidate
+		}
+	}
+	return ""
+}
+
+func suggestWeek(tokens []string) string {
+	// A week/day such as "2019-W05-3" or "2019W053" still tells us the week.
+	year, week, _, ok := weekTokens(tokens)
+	if !ok {
+		return ""
+	}
+	candidate := year + "-W" + week
+	if _, _, err := ParseWeek(candidate); err != nil {
+		return ""
+	}
+	return candidate
+}
+
+func suggestWeekDay(tokens []string) string {
+	year, week, day, ok := weekTokens(tokens)
+	if !ok || day == "" {
+		return ""
+	}
+	candidate := year + "-W" + week + "-" + day
+	if _, _, _, err := ParseWeekDay(candidate); err != nil {
+		return ""
+	}
+	return candidate
+}
+
+func suggestYearMonth(tokens []string) string {
+	// A full date such as "2019-05-22" still tells us the year/month.
+	if (len(tokens) != 2 && len(tokens) != 3) || !allDigits(tokens...) || len(tokens[0]) != 4 {
+		return ""
+	}
+	candidate := tokens[0] + "-" + pad(tokens[1], 2)
+	if _, _, err := ParseYearMonth(candidate); err != nil {
+		return ""
+	}
+	return candidate
+}
+
+func suggestMonthDay(tokens []string) string {
+	if len(tokens) != 2 || !allDigits(tokens...) {
+		return ""
+	}
+	candidates := []string{
+		"--" + pad(tokens[0], 2) + "-" + pad(tokens[1], 2),
+		"--" + pad(tokens[1], 2) + "-" + pad(tokens[0], 2),
+	}
+	for _, candidate := range candidates {
+		if _, _, err := ParseMonthDay(candidate); err == nil {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// weekTokens picks the (padded) year, week, and optional day out of tokens such as ["2019", "W", "5"],
+// ["2019", "W", "05", "3"] or ["2019", "W", "053"] (the basic "YYYYWwwD" format).
+func weekTokens(tokens []string) (year string, week string, day string, ok bool) {
+	if len(tokens) < 3 || len(tokens) > 4 || tokens[1] != "W" || len(tokens[0]) != 4 {
+		return "", "", "", false
+	}
+	if !allDigits(tokens[0], tokens[2]) {
+		return "", "", "", false
+	}
+
+	switch {
+	case len(tokens) == 4 && allDigits(tokens[3]):
+		return tokens[0], pad(tokens[2], 2), tokens[3], true
+	case len(tokens) == 3 && len(tokens[2]) == 3:
+		return tokens[0], tokens[2][0:2], tokens[2][2:], true
+	case len(tokens) == 3:
+		return tokens[0], pad(tokens[2], 2), "", true
+	}
+	return "", "", "", false
+}
+
+// tokenize breaks the input into runs of digits and runs of (upper-cased) letters, dropping any
+// separators in between. For example "2019/w5-3" becomes ["2019", "W", "5", "3"].
+func tokenize(input string) []string {
+	var tokens []string
+	var current strings.Builder
+	var currentIsDigit bool
+
+	flush := func() {
+		if current.Len() > 0 {
+			tokens = append(tokens, current.String())
+			current.Reset()
+		}
+	}
+	for _, r := range input {
+		switch {
+		case unicode.IsDigit(r):
+			if !currentIsDigit {
+				flush()
+			}
+			currentIsDigit = true
+			current.WriteRune(r)
+		case unicode.IsLetter(r):
+			if currentIsDigit {
+				flush()
+			}
+			currentIsDigit = false
+			current.WriteRune(unicode.ToUpper(r))
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+// pad adds leading zeros to the number until it has at least the given width.
+func pad(value string, width int) string {
+	if len(value) >= width {
+		return value
+	}
+	return strings.Repeat("0", width-len(value)) + value
+}
+
+func allDigits(values ...string) bool {
+	for _, value := range values {
+		for _, r := range value {
+			if r < '0' || r > '9' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func atoi(value string) int {
+	number, _ := strconv.Atoi(value)
+	return number
+}
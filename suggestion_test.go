@@ -0,0 +1,134 @@
+package isodates_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSuggestionSuite(t *testing.T) {
+	suite.Run(t, new(SuggestionSuite))
+}
+
+type SuggestionSuite struct {
+	ChronoSuite
+}
+
+// suggest returns the suggestion from the error, which must be a *ParseError.
+func (suite *SuggestionSuite) suggest(err error) string {
+	var parseErr *isodates.ParseError
+	if !suite.True(errors.As(err, &parseErr), "expected a *ParseError: %v", err) {
+		return ""
+	}
+	return parseErr.Suggestion()
+}
+
+func (suite *SuggestionSuite) TestDate() {
+	suggests := func(input string, expected string) {
+		_, _, _, err := isodates.ParseDate(input)
+		suite.Equal(expected, suite.suggest(err), input)
+	}
+	suggests("2019-5-3", "2019-05-03")
+	suggests("2019-05-3", "2019-05-03")
+	suggests("2019/05/03", "2019-05-03")
+	suggests("2019.5.3", "2019-05-03")
+	suggests("2019-22-05", "2019-05-22")
+	suggests("22/05/2019", "2019-05-22")
+	suggests("05/22/2019", "2019-05-22")
+
+	// Nothing obvious to suggest
+	suggests("", "")
+	suggests("garbage", "")
+	suggests("05/06/2019", "")
+	suggests("2019-13-13", "")
+	suggests("2019-02-29", "")
+	suggests("19-05-22", "")
+}
+
+func (suite *SuggestionSuite) TestWeek() {
+	suggests := func(input string, expected string) {
+		_, _, err := isodates.ParseWeek(input)
+		suite.Equal(expected, suite.suggest(err), input)
+	}
+	suggests("2019-W5", "2019-W05")
+	suggests("2019-w05", "2019-W05")
+	suggests("2019-w5", "2019-W05")
+	suggests("2019w05", "2019-W05")
+	suggests("2019/W05", "2019-W05")
+	suggests("2019-W05-3", "2019-W05")
+	suggests("2019W053", "2019-W05")
+
+	suggests("2019-W54", "")
+	suggests("2019-w53", "")
+	suggests("2019W531", "")
+	suggests("2020-w53", "2020-W53")
+	suggests("2019-05", "")
+	suggests("2019-X05", "")
+}
+
+func (suite *SuggestionSuite) TestWeekDay() {
+	suggests := func(input string, expected string) {
+		_, _, _, err := isodates.ParseWeekDay(input)
+		suite.Equal(expected, suite.suggest(err), input)
+	}
+	suggests("2019-W5-3", "2019-W05-3")
+	suggests("2019-w05-3", "2019-W05-3")
+	suggests("2019w053", "2019-W05-3")
+	suggests("2019/W05/3", "2019-W05-3")
+
+	suggests("2019-W05", "")
+	suggests("2019-W05-8", "")
+	suggests("2019w531", "")
+	suggests("2019/W53/1", "")
+	suggests("2020w531", "2020-W53-1")
+}
+
+func (suite *SuggestionSuite) TestYearMonth() {
+	suggests := func(input string, expected string) {
+		_, _, err := isodates.ParseYearMonth(input)
+		suite.Equal(expected, suite.suggest(err), input)
+	}
+	suggests("2019-5", "2019-05")
+	suggests("2019/05", "2019-05")
+	suggests("2019-05-22", "2019-05")
+
+	suggests("2019-13", "")
+	suggests("05-2019", "")
+}
+
+func (suite *SuggestionSuite) TestMonthDay() {
+	suggests := func(input string, expected string) {
+		_, _, err := isodates.ParseMonthDay(input)
+		suite.Equal(expected, suite.suggest(err), input)
+	}
+	suggests("12-25", "--12-25")
+	suggests("12/25", "--12-25")
+	suggests("--25-12", "--12-25")
+	suggests("--02-30", "")
+	suggests("--13-13", "")
+}
+
+func (suite *SuggestionSuite) TestHint() {
+	_, _, err := isodates.ParseWeek("2019-W5")
+	var parseErr *isodates.ParseError
+	_ = suite.True(errors.As(err, &parseErr)) &&
+		suite.Equal("did you mean 2019-W05?", parseErr.Hint())
+
+	_, _, err = isodates.ParseWeek("2019-W54")
+	_ = suite.True(errors.As(err, &parseErr)) &&
+		suite.Equal("", parseErr.Hint())
+}
+
+func ExampleParseError_Hint() {
+	_, _, _, err := isodates.ParseDate("2019/5/3")
+
+	var parseErr *isodates.ParseError
+	if errors.As(err, &parseErr) {
+		fmt.Println(parseErr.Error() + ": " + parseErr.Hint())
+	}
+
+	// Output: invalid YYYY-MM-DD format: 2019/5/3: did you mean 2019-05-03?
+}
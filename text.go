@@ -0,0 +1,213 @@
+package isodates
+
+import "time"
+
+/*
+ * The value types implement encoding.TextMarshaler/TextUnmarshaler using their ISO strings, so they
+ * work with encoding/xml, config/env loaders, and as map keys. They also implement flag.Value, so you
+ * can use flag.Var() to accept "--week 2019-W05" and have it validated by the usual parser. The zero
+ * value is written as empty text, and empty text is read as the zero value.
+ */
+
+// MarshalText encodes the date as an ISO string such as "2019-05-22".
+func (date Date) MarshalText() ([]byte, error) {
+	return marshalText(date, date.IsZero())
+}
+
+// UnmarshalText decodes an ISO string such as "2019-05-22", following the same rules as ParseDate.
+func (date *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*date = Date{}
+		return nil
+	}
+	value, err := ParseDateValue(string(text))
+	if err != nil {
+		return err
+	}
+	*date = value
+	return nil
+}
+
+// Set implements flag.Value, so you can use a Date as a command line flag.
+func (date *Date) Set(input string) error {
+	return date.UnmarshalText([]byte(input))
+}
+
+// MarshalText encodes the week as an ISO string such as "2019-W05".
+func (week Week) MarshalText() ([]byte, error) {
+	return marshalText(week, week.IsZero())
+}
+
+// UnmarshalText decodes an ISO string such as "2019-W05", following the same rules as ParseWeek.
+func (week *Week) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*week = Week{}
+		return nil
+	}
+	value, err := ParseWeekValue(string(text))
+	if err != nil {
+		return err
+	}
+	*week = value
+	return nil
+}
+
+// Set implements flag.Value, so you can use a Week as a command line flag.
+func (week *Week) Set(input string) error {
+	return week.UnmarshalText([]byte(input))
+}
+
+// MarshalText encodes the week/day as an ISO string such as "2019-W05-3".
+func (weekDay WeekDay) MarshalText() ([]byte, error) {
+	return marshalText(weekDay, weekDay.IsZero())
+}
+
+// UnmarshalText decodes an ISO string such as "2019-W05-3", following the same rules as ParseWeekDay.
+func (weekDay *WeekDay) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*weekDay = WeekDay{}
+		return nil
+	}
+	value, err := ParseWeekDayValue(string(text))
+	if err != nil {
+		return err
+	}
+	*weekDay = value
+	return nil
+}
+
+// Set implements flag.Value, so you can use a WeekDay as a command line flag.
+func (weekDay *WeekDay) Set(input string) error {
+	return weekDay.UnmarshalText([]byte(input))
+}
+
+// MarshalText encodes the year/month as an ISO string such as "2019-05".
+func (yearMonth YearMonth) MarshalText() ([]byte, error) {
+	return marshalText(yearMonth, yearMonth.IsZero())
+}
+
+// UnmarshalText decodes an ISO string such as "2019-05", following the same rules as ParseYearMonth.
+func (yearMonth *YearMonth) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*yearMonth = YearMonth{}
+		return nil
+	}
+	value, err := ParseYearMonthValue(string(text))
+	if err != nil {
+		return err
+	}
+	*yearMonth = value
+	return nil
+}
+
+// Set implements flag.Value, so you can use a YearMonth as a command line flag.
+func (yearMonth *YearMonth) Set(input string) error {
+	return yearMonth.UnmarshalText([]byte(input))
+}
+
+// MarshalText encodes the month/day as an ISO string such as "--05-22".
+func (monthDay MonthDay) MarshalText() ([]byte, error) {
+	return marshalText(monthDay, monthDay.IsZero())
+}
+
+// UnmarshalText decodes an ISO string such as "--05-22", following the same rules as ParseMonthDay.
+func (monthDay *MonthDay) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*monthDay = MonthDay{}
+		return nil
+	}
+	value, err := ParseMonthDayValue(string(text))
+	if err != nil {
+		return err
+	}
+	*monthDay = value
+	return nil
+}
+
+// Set implements flag.Value, so you can use a MonthDay as a command line flag.
+func (monthDay *MonthDay) Set(input string) error {
+	return monthDay.UnmarshalText([]byte(input))
+}
+
+// String returns the range as an ISO 8601 interval of RFC 3339 timestamps such as
+// "2019-05-22T00:00:00Z/2019-05-22T23:59:59.999999999Z". Each timestamp keeps the offset of its location.
+// ISO 8601 intervals have no way to mark an exclusive end, so a half-open range is written with the
+// inclusive end that goes with it (11:59:59pm the day before an End at midnight). Reading it back gives
+// you the inclusive range, and HalfOpen() turns that back into the original. Unbounded sides are
+// written as ".." (e.g. "2019-05-22T00:00:00Z/.."), which is how ISO 8601-2 writes open intervals.
+func (r Range) String() string {
+	if r.isZero() {
+		return ""
+	}
+	start, end := "..", ".."
+	if !r.LowerUnbounded {
+		start = r.Start.Format(time.RFC3339Nano)
+	}
+	if !r.UpperUnbounded && r.Exclusive {
+		end = instantBefore(r.End).Format(time.RFC3339Nano)
+	} else if !r.UpperUnbounded {
+		end = r.End.Format(time.RFC3339Nano)
+	}
+	return start + "/" + end
+}
+
+// MarshalText encodes the duration as an ISO string such as "P1DT12H". Durations that mix positive and
+// negative components can't be written as ISO strings, so they result in an error.
+func (d Duration) MarshalText() ([]byte, error) {
+	if err := d.checkSigns(); err != nil {
+		return nil, err
+	}
+	return marshalText(d, d.IsZero())
+}
+
+// UnmarshalText decodes an ISO string such as "P1DT12H", following the same rules as ParseDuration.
+func (d *Duration) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Duration{}
+		return nil
+	}
+	value, err := ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = value
+	return nil
+}
+
+// Set implements flag.Value, so you can use a Duration as a command line flag (e.g. "--lead P2W").
+func (d *Duration) Set(input string) error {
+	return d.UnmarshalText([]byte(input))
+}
+
+// MarshalText encodes the range as an ISO 8601 interval (see String).
+func (r Range) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText decodes an ISO 8601 interval such as "2019-05-01/P1M", following the same rules as
+// ParseInterval. Like ParseInterval, the result is always inclusive, so a half-open range that went
+// through MarshalText comes back as the equivalent inclusive range (see String).
+func (r *Range) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*r = Range{}
+		return nil
+	}
+	value, err := ParseInterval(string(text))
+	if err != nil {
+		return err
+	}
+	*r = value
+	return nil
+}
+
+// Set implements flag.Value, so you can use a Range as a command line flag (e.g. "--range 2019-05-01/P1M").
+func (r *Range) Set(input string) error {
+	return r.UnmarshalText([]byte(input))
+}
+
+func marshalText(value interface{ String() string }, isZero bool) ([]byte, error) {
+	if isZero {
+		return []byte{}, nil
+	}
+	return []byte(value.String()), nil
+}
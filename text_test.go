@@ -0,0 +1,242 @@
+package isodates_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestTextSuite(t *testing.T) {
+	suite.Run(t, new(TextSuite))
+}
+
+type TextSuite struct {
+	ChronoSuite
+}
+
+// Make sure that all of the value types can be used with the standard interfaces.
+var (
+	_ encoding.TextMarshaler   = isodates.Date{}
+	_ encoding.TextUnmarshaler = &isodates.Date{}
+	_ flag.Value               = &isodates.Date{}
+	_ flag.Value               = &isodates.Week{}
+	_ flag.Value               = &isodates.WeekDay{}
+	_ flag.Value               = &isodates.YearMonth{}
+	_ flag.Value               = &isodates.MonthDay{}
+	_ flag.Value               = &isodates.Range{}
+	_ flag.Value               = &isodates.Duration{}
+)
+
+func (suite *TextSuite) TestRoundTrip() {
+	check := func(value encoding.TextMarshaler, target encoding.TextUnmarshaler, text string) {
+		actual, err := value.MarshalText()
+		_ = suite.NoError(err) &&
+			suite.Equal(text, string(actual)) &&
+			suite.NoError(target.UnmarshalText(actual))
+	}
+
+	var date isodates.Date
+	check(isodates.Date{Year: 2019, Month: time.May, Day: 22}, &date, "2019-05-22")
+	suite.Equal(isodates.Date{Year: 2019, Month: time.May, Day: 22}, date)
+
+	var week isodates.Week
+	check(isodates.Week{Year: 2019, Week: 5}, &week, "2019-W05")
+	suite.Equal(isodates.Week{Year: 2019, Week: 5}, week)
+
+	var weekDay isodates.WeekDay
+	check(isodates.WeekDay{Year: 2019, Week: 5, Day: 3}, &weekDay, "2019-W05-3")
+	suite.Equal(isodates.WeekDay{Year: 2019, Week: 5, Day: 3}, weekDay)
+
+	var yearMonth isodates.YearMonth
+	check(isodates.YearMonth{Year: 2019, Month: time.May}, &yearMonth, "2019-05")
+	suite.Equal(isodates.YearMonth{Year: 2019, Month: time.May}, yearMonth)
+
+	var monthDay isodates.MonthDay
+	check(isodates.MonthDay{Month: time.December, Day: 25}, &monthDay, "--12-25")
+	suite.Equal(isodates.MonthDay{Month: time.December, Day: 25}, monthDay)
+
+	var d isodates.Duration
+	check(isodates.Duration{Days: 14, Clock: 90 * time.Minute}, &d, "P14DT1H30M")
+	suite.Equal(isodates.Duration{Days: 14, Clock: 90 * time.Minute}, d)
+
+	var r isodates.Range
+	check(day(2019, time.May, 22), &r, "2019-05-22T00:00:00Z/2019-05-22T23:59:59.999999999Z")
+	suite.True(day(2019, time.May, 22).Equal(r))
+
+	// The interval's end is inclusive, so half-open ranges come back as the equivalent inclusive range,
+	// which HalfOpen() turns back into the original.
+	check(day(2019, time.May, 22).HalfOpen(), &r, "2019-05-22T00:00:00Z/2019-05-22T23:59:59.999999999Z")
+	suite.False(r.Exclusive)
+	suite.True(day(2019, time.May, 22).Equal(r))
+	suite.True(day(2019, time.May, 22).HalfOpen().Equal(r.HalfOpen()))
+
+	// Timestamps keep their offsets.
+	local, _ := isodates.ParseDateRangeIn("2019-05-22", locationEDT)
+	check(local.HalfOpen(), &r, "2019-05-22T00:00:00-04:00/2019-05-22T23:59:59.999999999-04:00")
+	_ = suite.True(local.HalfOpen().Equal(r.HalfOpen())) &&
+		suite.Equal("2019-05-22T00:00:00-04:00", r.Start.Format(time.RFC3339)) &&
+		suite.Equal("2019-05-23T00:00:00-04:00", r.HalfOpen().End.Format(time.RFC3339))
+
+	// Unbounded sides are written as "..".
+	from2019 := isodates.Range{Start: time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC), UpperUnbounded: true}
+	check(from2019, &r, "2019-01-01T00:00:00Z/..")
+	suite.True(from2019.Equal(r))
+	check(isodates.Range{LowerUnbounded: true, UpperUnbounded: true}, &r, "../..")
+	suite.Equal(isodates.Range{LowerUnbounded: true, UpperUnbounded: true}, r)
+
+	// Zero values are empty text and vice versa.
+	week = isodates.Week{Year: 2019, Week: 5}
+	check(isodates.Week{}, &week, "")
+	suite.True(week.IsZero())
+	check(isodates.Range{}, &r, "")
+	suite.Equal(isodates.Range{}, r)
+	check(isodates.Duration{}, &d, "")
+	suite.True(d.IsZero())
+}
+
+func (suite *TextSuite) TestParseErrors() {
+	fails := func(target encoding.TextUnmarshaler, text string) {
+		var parseErr *isodates.ParseError
+		suite.True(errors.As(target.UnmarshalText([]byte(text)), &parseErr), text)
+	}
+	fails(&isodates.Date{}, "2019-02-29")
+	fails(&isodates.Week{}, "2019-W53")
+	fails(&isodates.WeekDay{}, "2019-W05-0")
+	fails(&isodates.YearMonth{}, "2019-00")
+	fails(&isodates.MonthDay{}, "--13-01")
+	fails(&isodates.Duration{}, "P1X")
+	fails(&isodates.Range{}, "2019-W53")
+	fails(&isodates.Range{}, "2019-05-01/2019-W53")
+	fails(&isodates.Range{}, "2019-05-01/P")
+	fails(&isodates.Range{}, "2019-05-01/P1X")
+	fails(&isodates.Range{}, "2019-05-01/P1H")
+	fails(&isodates.Range{}, "2019-05-01/PT1D")
+	fails(&isodates.Range{}, "2019-05-01/P1DT")
+	fails(&isodates.Range{}, "2019-05-01/P1")
+	fails(&isodates.Range{}, "P1D/P1D")
+	fails(&isodates.Range{}, "P1D/..")
+	fails(&isodates.Range{}, "../P1D")
+	fails(&isodates.Range{}, "2019-06-01/2019-05-01")
+}
+
+func (suite *TextSuite) TestRangeText() {
+	succeeds := func(text string, expected isodates.Range) {
+		var r isodates.Range
+		_ = suite.NoError(r.UnmarshalText([]byte(text)), text) &&
+			suite.True(expected.Equal(r), "%s: %v", text, r)
+	}
+
+	succeeds("2019-W05", days(2019, time.January, 28, 2019, time.February, 3))
+	succeeds("2019-05", days(2019, time.May, 1, 2019, time.May, 31))
+	succeeds("2019-05-01/2019-05-31", days(2019, time.May, 1, 2019, time.May, 31))
+	succeeds("2019-05-01/2019-W22", days(2019, time.May, 1, 2019, time.June, 2))
+	succeeds("2019-05/2019-07", days(2019, time.May, 1, 2019, time.July, 31))
+	succeeds("2019-05-01/P1M", days(2019, time.May, 1, 2019, time.May, 31))
+	succeeds("20190501/P1M", days(2019, time.May, 1, 2019, time.May, 31))
+	succeeds("2019W05/2019W06", days(2019, time.January, 28, 2019, time.February, 10))
+	succeeds("+002019-05", days(2019, time.May, 1, 2019, time.May, 31))
+	succeeds("2019-05-01/P2W", days(2019, time.May, 1, 2019, time.May, 14))
+	succeeds("2019-05-01/P1Y", days(2019, time.May, 1, 2020, time.April, 30))
+	succeeds("2019-05-01/P1DT12H", isodates.Range{
+		Start: time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2019, time.May, 2, 11, 59, 59, 999999999, time.UTC),
+	})
+	succeeds("2019-05-01T10:00:00Z/PT1.5S", isodates.Range{
+		Start: time.Date(2019, time.May, 1, 10, 0, 0, 0, time.UTC),
+		End:   time.Date(2019, time.May, 1, 10, 0, 1, 499999999, time.UTC),
+	})
+	succeeds("P1M/2019-05-31", days(2019, time.May, 1, 2019, time.May, 31))
+	succeeds("P1W/2019-W05", days(2019, time.January, 28, 2019, time.February, 3))
+	succeeds("2019-05-22T04:00:00Z/2019-05-23T03:59:59Z", isodates.Range{
+		Start: time.Date(2019, time.May, 22, 4, 0, 0, 0, time.UTC),
+		End:   time.Date(2019, time.May, 23, 3, 59, 59, 0, time.UTC),
+	})
+	succeeds("2019-05-01/..", isodates.Range{
+		Start:          time.Date(2019, time.May, 1, 0, 0, 0, 0, time.UTC),
+		UpperUnbounded: true,
+	})
+	succeeds("../2019-05", isodates.Range{
+		End:            time.Date(2019, time.May, 31, 23, 59, 59, 999999999, time.UTC),
+		LowerUnbounded: true,
+	})
+	succeeds("../..", isodates.Range{LowerUnbounded: true, UpperUnbounded: true})
+}
+
+func (suite *TextSuite) TestFlags() {
+	flags := flag.NewFlagSet("report", flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+
+	var week isodates.Week
+	var month isodates.YearMonth
+	var period isodates.Range
+	var lead isodates.Duration
+	flags.Var(&week, "week", "the week to report on")
+	flags.Var(&month, "month", "the month to report on")
+	flags.Var(&period, "range", "the range to report on")
+	flags.Var(&lead, "lead", "how far ahead to report")
+
+	err := flags.Parse([]string{"--week", "2019-W05", "--month=2019-05", "--range", "2019-05-01/P1M", "--lead", "P2W"})
+	_ = suite.NoError(err) &&
+		suite.Equal(isodates.Week{Year: 2019, Week: 5}, week) &&
+		suite.Equal(isodates.YearMonth{Year: 2019, Month: time.May}, month) &&
+		suite.True(days(2019, time.May, 1, 2019, time.May, 31).Equal(period)) &&
+		suite.Equal(isodates.Duration{Days: 14}, lead)
+
+	suite.Error(flags.Parse([]string{"--week", "2019-W53"}))
+	suite.Error(flags.Parse([]string{"--range", "2019-05-01/P1Q"}))
+	suite.Error(flags.Parse([]string{"--lead", "2W"}))
+}
+
+func (suite *TextSuite) TestXML() {
+	type report struct {
+		Week   isodates.Week      `xml:"week,attr"`
+		Month  isodates.YearMonth `xml:"month"`
+		Period isodates.Range     `xml:"period"`
+	}
+	expected := report{
+		Week:   isodates.Week{Year: 2019, Week: 5},
+		Month:  isodates.YearMonth{Year: 2019, Month: time.May},
+		Period: days(2019, time.May, 1, 2019, time.May, 31),
+	}
+	data, err := xml.Marshal(expected)
+	suite.Require().NoError(err)
+	suite.Equal(`<report week="2019-W05"><month>2019-05</month><period>2019-05-01T00:00:00Z/2019-05-31T23:59:59.999999999Z</period></report>`, string(data))
+
+	var actual report
+	_ = suite.NoError(xml.Unmarshal(data, &actual)) &&
+		suite.Equal(expected.Week, actual.Week) &&
+		suite.Equal(expected.Month, actual.Month) &&
+		suite.True(expected.Period.Equal(actual.Period))
+}
+
+func (suite *TextSuite) TestMapKeys() {
+	counts := map[isodates.Week]int{{Year: 2019, Week: 5}: 3, {Year: 2020, Week: 53}: 7}
+	data, err := json.Marshal(counts)
+	_ = suite.NoError(err) && suite.JSONEq(`{"2019-W05": 3, "2020-W53": 7}`, string(data))
+
+	var actual map[isodates.Week]int
+	_ = suite.NoError(json.Unmarshal(data, &actual)) && suite.Equal(counts, actual)
+}
+
+func ExampleRange_Set() {
+	var period isodates.Range
+	flags := flag.NewFlagSet("report", flag.ExitOnError)
+	flags.Var(&period, "range", "the range to report on")
+	_ = flags.Parse([]string{"--range", "2019-05-01/P1M"})
+
+	fmt.Println(period.Start)
+	fmt.Println(period.End)
+
+	// Output:
+	// 2019-05-01 00:00:00 +0000 UTC
+	// 2019-05-31 23:59:59.999999999 +0000 UTC
+}
@@ -0,0 +1,8 @@
+//go:build go1.15
+// +build go1.15
+
+package isodates_test
+
+// The DST tests shouldn't depend on the zone database of the machine running them, so embed one when
+// the Go version has it. The library itself doesn't need this, so it only applies to the tests.
+import _ "time/tzdata"
@@ -0,0 +1,179 @@
+package isodates
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+/*
+ * Voice and NLU platforms (e.g. Alexa's AMAZON.DATE slot) will give you dates where the year was
+ * never uttered, so they replace it with "XXXX" (e.g. "XXXX-12-25" or "XXXX-W05-1"). Parse() will
+ * tell you which kind of value you have, but you need to decide which year the user most likely
+ * meant before you can get a range for it.
+ */
+
+// UnspecifiedYear is the placeholder that replaces the year in inputs such as "XXXX-12-25".
+const UnspecifiedYear = "XXXX"
+
+// standInYear is the year we put in place of "XXXX" to validate the rest of the input. It's a leap year
+// with 53 ISO weeks, so "XXXX-02-29" and "XXXX-W53" are fine since they exist in some years.
+const standInYear = 2020
+
+// YearRule determines which year is chosen when resolving an input with an unspecified year.
+type YearRule int
+
+const (
+	// NearestYear picks the year whose occurrence is closest to the reference time. If the reference
+	// time is inside of one of the occurrences, that's the one you get.
+	NearestYear YearRule = iota
+	// NextYear picks the first occurrence that ends on or after the reference time.
+	NextYear
+	// PreviousYear picks the most recent occurrence that starts on or before the reference time.
+	PreviousYear
+)
+
+// HasUnspecifiedYear returns true if the input is a date, year/month, week, or week/day string whose
+// year is "XXXX" (e.g. "XXXX-12-25", "XXXX-05", "XXXX-W05", or "XXXX-W05-1").
+func HasUnspecifiedYear(input string) bool {
+	return strings.HasPrefix(input, UnspecifiedYear+"-")
+}
+
+// Resolve replaces the unspecified year in the input (e.g. "XXXX-12-25") with the year chosen by the
+// rule relative to the reference time, and returns the resulting string (e.g. "2019-12-25"). Occurrences
+// are compared using midnight/11:59:59pm in the given location, so "today" is the local calendar date.
+// Inputs that already have a year are returned as-is.
+//
+// Years in which the value doesn't exist are skipped, so "XXXX-02-29" only ever resolves to leap years
+// and "XXXX-W53" only resolves to years with 53 ISO weeks.
+func (rule YearRule) Resolve(input string, ref time.Time, loc *time.Location) (string, error) {
+	if loc == nil {
+		return "", fmt.Errorf("resolve year: %w", ErrNilLocation)
+	}
+	if !HasUnspecifiedYear(input) {
+		return input, nil
+	}
+	if _, err := Parse(input); err != nil {
+		return "", err
+	}
+
+	switch rule {
+	case NextYear:
+		resolved, _, err := rule.findNext(input, ref, loc)
+		return resolved, err
+	case PreviousYear:
+		resolved, _, err := rule.findPrevious(input, ref, loc)
+		return resolved, err
+	case NearestYear:
+		next, nextDistance, nextErr := rule.findNext(input, ref, loc)
+		previous, previousDistance, previousErr := rule.findPrevious(input, ref, loc)
+		switch {
+		case nextErr != nil && previousErr != nil:
+			return "", nextErr
+		case nextErr != nil:
+			return previous, nil
+		case previousErr != nil:
+			return next, nil
+		case previousDistance < nextDistance:
+			return previous, nil
+		default:
+			return next, nil
+		}
+	default:
+		return "", fmt.Errorf("invalid year rule: %d", rule)
+	}
+}
+
+// ResolveRange resolves the unspecified year in the input (see Resolve) and returns the range from
+// midnight on the first day through 11:59:59pm on the last day of the resulting date, year/month, week,
+// or week/day in the given location.
+func (rule YearRule) ResolveRange(input string, ref time.Time, loc *time.Location) (Range, error) {
+	resolved, err := rule.Resolve(input, ref, loc)
+	if err != nil {
+		return Range{}, err
+	}
+	return parseRangeIn(resolved, loc)
+}
+
+// findNext finds the first occurrence of the input that ends on or after the reference time. It also
+// returns how long after the reference time that occurrence starts (zero if it contains it).
+func (rule YearRule) findNext(input string, ref time.Time, loc *time.Location) (string, time.Duration, error) {
+	var err error = formatError("XXXX-MM-DD", input, len(UnspecifiedYear))
+	for year := ref.Year() - 1; year <= ref.Year()+10; year++ {
+		resolved := withYear(input, year)
+		r, rangeErr := parseRangeIn(resolved, loc)
+		if rangeErr != nil {
+			err = rangeErr
+			continue
+		}
+		if r.End.Before(ref) {
+			continue
+		}
+		if r.Start.After(ref) {
+			return resolved, r.Start.Sub(ref), nil
+		}
+		return resolved, 0, nil
+	}
+	return "", 0, err
+}
+
+// findPrevious finds the most recent occurrence of the input that starts on or before the reference time.
+// It also returns how long before the reference time that occurrence ends (zero if it contains it).
+func (rule YearRule) findPrevious(input string, ref time.Time, loc *time.Location) (string, time.Duration, error) {
+	var err error = formatError("XXXX-MM-DD", input, len(UnspecifiedYear))
+	for year := ref.Year() + 1; year >= ref.Year()-10; year-- {
+		resolved := withYear(input, year)
+		r, rangeErr := parseRangeIn(resolved, loc)
+		if rangeErr != nil {
+			err = rangeErr
+			continue
+		}
+		if r.Start.After(ref) {
+			continue
+		}
+		if r.End.Before(ref) {
+			return resolved, ref.Sub(r.End), nil
+		}
+		return resolved, 0, nil
+	}
+	return "", 0, err
+}
+
+// withYear replaces the "XXXX" year placeholder with the given (zero padded) year.
+func withYear(input string, year int) string {
+	return fmt.Sprintf("%04d", year) + input[len(UnspecifiedYear):]
+}
+
+// parseRangeIn parses the date, year/month, week, or week/day string (see Parse) and returns the range
+// it represents. Date/times and month/days aren't ranges you can resolve a year for.
+func parseRangeIn(input string, loc *time.Location) (Range, error) {
+	value, err := Parse(input)
+	if err != nil {
+		return Range{}, err
+	}
+	if value.Kind == KindDateTime || value.Kind == KindMonthDay {
+		return Range{}, formatError("YYYY-MM-DD", input, 0)
+	}
+	return value.Range(loc)
+}
+
+// parseUnspecifiedYear parses a date, year/month, week, or week/day whose year is "XXXX". The rest of the
+// input is checked against standInYear, so you only get errors for values that don't exist in any year.
+func parseUnspecifiedYear(input string) (Value, error) {
+	value, err := Parse(withYear(input, standInYear))
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) {
+		// The offsets still line up since the stand-in is also 4 digits, but any detail would be about
+		// the stand-in year rather than what the caller gave us.
+		parseErr.Input, parseErr.detail = input, ""
+	}
+	switch {
+	case err != nil:
+		return Value{}, err
+	case value.Kind == KindDateTime || value.Kind == KindMonthDay:
+		return Value{}, formatError("XXXX-MM-DD", input, 0)
+	}
+	value.Year, value.YearUnspecified = 0, true
+	return value, nil
+}
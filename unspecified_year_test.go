@@ -0,0 +1,158 @@
+package isodates_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestUnspecifiedYearSuite(t *testing.T) {
+	suite.Run(t, new(UnspecifiedYearSuite))
+}
+
+type UnspecifiedYearSuite struct {
+	ChronoSuite
+}
+
+func (suite *UnspecifiedYearSuite) TestHasUnspecifiedYear() {
+	suite.True(isodates.HasUnspecifiedYear("XXXX-12-25"))
+	suite.True(isodates.HasUnspecifiedYear("XXXX-12"))
+	suite.True(isodates.HasUnspecifiedYear("XXXX-W05"))
+	suite.True(isodates.HasUnspecifiedYear("XXXX-W05-1"))
+
+	suite.False(isodates.HasUnspecifiedYear(""))
+	suite.False(isodates.HasUnspecifiedYear("XXXX"))
+	suite.False(isodates.HasUnspecifiedYear("xxxx-12-25"))
+	suite.False(isodates.HasUnspecifiedYear("2019-12-25"))
+	suite.False(isodates.HasUnspecifiedYear("--12-25"))
+}
+
+func (suite *UnspecifiedYearSuite) TestParse() {
+	succeeds := func(input string, expected isodates.Value) {
+		value, err := isodates.Parse(input)
+		_ = suite.NoError(err, input) && suite.Equal(expected, value, input)
+	}
+	fails := func(input string, reason isodates.Reason) {
+		_, err := isodates.Parse(input)
+		var parseErr *isodates.ParseError
+		_ = suite.True(errors.As(err, &parseErr), input) &&
+			suite.Equal(string(reason), string(parseErr.Reason), input) &&
+			suite.Equal(input, parseErr.Input, input)
+	}
+	fails("XXXX", isodates.ReasonInvalidFormat)
+	fails("XXXX-13-01", isodates.ReasonOutOfRange)
+	fails("XXXX-02-30", isodates.ReasonNonexistent)
+	fails("XXXX-W54", isodates.ReasonOutOfRange)
+	fails("XXXX-W05-8", isodates.ReasonOutOfRange)
+	fails("XXXX-05-22T12:33:53Z", isodates.ReasonInvalidFormat)
+
+	succeeds("XXXX-12-25", isodates.Value{Kind: isodates.KindDate, Month: time.December, Day: 25, YearUnspecified: true})
+	succeeds("XXXX-02-29", isodates.Value{Kind: isodates.KindDate, Month: time.February, Day: 29, YearUnspecified: true})
+	succeeds("XXXX-05", isodates.Value{Kind: isodates.KindYearMonth, Month: time.May, YearUnspecified: true})
+	succeeds("XXXX-W53", isodates.Value{Kind: isodates.KindWeek, Week: 53, YearUnspecified: true})
+	succeeds("XXXX-W05-1", isodates.Value{Kind: isodates.KindWeekDay, Week: 5, WeekDay: 1, YearUnspecified: true})
+
+	_, err := isodates.Parse("XXXX-02-30")
+	suite.EqualError(err, "invalid day of month: 30")
+}
+
+func (suite *UnspecifiedYearSuite) TestResolve() {
+	succeeds := func(rule isodates.YearRule, input string, ref time.Time, expected string) {
+		actual, err := rule.Resolve(input, ref, locationEDT)
+		_ = suite.NoError(err) && suite.Equal(expected, actual)
+	}
+	fails := func(rule isodates.YearRule, input string, ref time.Time, loc *time.Location) {
+		_, err := rule.Resolve(input, ref, loc)
+		suite.Error(err)
+	}
+	ref := time.Date(2019, time.June, 15, 12, 0, 0, 0, locationEDT)
+	fails(isodates.NearestYear, "XXXX-12-25", ref, nil)
+	fails(isodates.NearestYear, "XXXX-", ref, locationEDT)
+	fails(isodates.NearestYear, "XXXX-12-32", ref, locationEDT)
+	fails(isodates.NearestYear, "XXXX-02-30", ref, locationEDT)
+	fails(isodates.NextYear, "XXXX-13", ref, locationEDT)
+	fails(isodates.PreviousYear, "XXXX-W54", ref, locationEDT)
+	fails(isodates.PreviousYear, "XXXX-W05-8", ref, locationEDT)
+	fails(isodates.YearRule(99), "XXXX-12-25", ref, locationEDT)
+
+	// Inputs with an actual year are left alone.
+	succeeds(isodates.NearestYear, "2010-12-25", ref, "2010-12-25")
+	succeeds(isodates.NextYear, "2010-W05", ref, "2010-W05")
+
+	succeeds(isodates.NextYear, "XXXX-12-25", ref, "2019-12-25")
+	succeeds(isodates.NextYear, "XXXX-01-01", ref, "2020-01-01")
+	succeeds(isodates.NextYear, "XXXX-06-15", ref, "2019-06-15")
+	succeeds(isodates.NextYear, "XXXX-06", ref, "2019-06")
+	succeeds(isodates.NextYear, "XXXX-05", ref, "2020-05")
+	succeeds(isodates.NextYear, "XXXX-W24", ref, "2019-W24")
+	succeeds(isodates.NextYear, "XXXX-W24-5", ref, "2020-W24-5")
+	succeeds(isodates.NextYear, "XXXX-W24-6", ref, "2019-W24-6")
+	succeeds(isodates.NextYear, "XXXX-02-29", ref, "2020-02-29")
+	succeeds(isodates.NextYear, "XXXX-W53", ref, "2020-W53")
+
+	succeeds(isodates.PreviousYear, "XXXX-12-25", ref, "2018-12-25")
+	succeeds(isodates.PreviousYear, "XXXX-01-01", ref, "2019-01-01")
+	succeeds(isodates.PreviousYear, "XXXX-06-15", ref, "2019-06-15")
+	succeeds(isodates.PreviousYear, "XXXX-07", ref, "2018-07")
+	succeeds(isodates.PreviousYear, "XXXX-W24", ref, "2019-W24")
+	succeeds(isodates.PreviousYear, "XXXX-W24-7", ref, "2018-W24-7")
+	succeeds(isodates.PreviousYear, "XXXX-02-29", ref, "2016-02-29")
+	succeeds(isodates.PreviousYear, "XXXX-W53", ref, "2015-W53")
+
+	succeeds(isodates.NearestYear, "XXXX-06-15", ref, "2019-06-15")
+	succeeds(isodates.NearestYear, "XXXX-06", ref, "2019-06")
+	succeeds(isodates.NearestYear, "XXXX-12-25", ref, "2018-12-25")
+	succeeds(isodates.NearestYear, "XXXX-12-01", ref, "2019-12-01")
+	succeeds(isodates.NearestYear, "XXXX-01-15", ref, "2019-01-15")
+	succeeds(isodates.NearestYear, "XXXX-W50", ref, "2019-W50")
+	succeeds(isodates.NearestYear, "XXXX-02-29", ref, "2020-02-29")
+	succeeds(isodates.NearestYear, "XXXX-W53", ref, "2020-W53")
+
+	// "Today" is based on the calendar date in the given location. It's 10pm
+	// on the 14th in LA, but 1am on the 15th in NY.
+	ref = time.Date(2019, time.June, 15, 5, 0, 0, 0, time.UTC)
+	succeeds(isodates.NextYear, "XXXX-06-14", ref, "2020-06-14")
+	succeeds(isodates.PreviousYear, "XXXX-06-16", ref, "2018-06-16")
+}
+
+func (suite *UnspecifiedYearSuite) TestResolveRange() {
+	succeeds := func(rule isodates.YearRule, input string, ref time.Time, startYear int, startMonth time.Month, startDay int, endYear int, endMonth time.Month, endDay int) {
+		r, err := rule.ResolveRange(input, ref, locationEDT)
+		_ = suite.AssertMidnightIn(r.Start, err, startYear, startMonth, startDay, locationEDT) &&
+			suite.AssertAlmostMidnightIn(r.End, err, endYear, endMonth, endDay, locationEDT)
+	}
+	fails := func(rule isodates.YearRule, input string, ref time.Time, loc *time.Location) {
+		_, err := rule.ResolveRange(input, ref, loc)
+		suite.Error(err)
+	}
+	ref := time.Date(2019, time.June, 15, 12, 0, 0, 0, locationEDT)
+	fails(isodates.NearestYear, "XXXX-12-25", ref, nil)
+	fails(isodates.NearestYear, "XXXX-12-32", ref, locationEDT)
+	fails(isodates.NearestYear, "2019-12-32", ref, locationEDT)
+	fails(isodates.NearestYear, "2019-12-25T10:00:00Z", ref, locationEDT)
+
+	succeeds(isodates.NearestYear, "2010-12-25", ref, 2010, time.December, 25, 2010, time.December, 25)
+	succeeds(isodates.NextYear, "XXXX-12-25", ref, 2019, time.December, 25, 2019, time.December, 25)
+	succeeds(isodates.PreviousYear, "XXXX-12-25", ref, 2018, time.December, 25, 2018, time.December, 25)
+	succeeds(isodates.NextYear, "XXXX-02", ref, 2020, time.February, 1, 2020, time.February, 29)
+	succeeds(isodates.NextYear, "XXXX-W01", ref, 2019, time.December, 30, 2020, time.January, 5)
+	succeeds(isodates.NearestYear, "XXXX-W05-1", ref, 2019, time.January, 28, 2019, time.January, 28)
+}
+
+func ExampleYearRule_Resolve() {
+	ref := time.Date(2019, time.June, 15, 12, 0, 0, 0, time.UTC)
+	next, _ := isodates.NextYear.Resolve("XXXX-12-25", ref, time.UTC)
+	previous, _ := isodates.PreviousYear.Resolve("XXXX-12-25", ref, time.UTC)
+	nearest, _ := isodates.NearestYear.Resolve("XXXX-12-25", ref, time.UTC)
+	fmt.Println(next)
+	fmt.Println(previous)
+	fmt.Println(nearest)
+
+	// Output: 2019-12-25
+	// 2018-12-25
+	// 2018-12-25
+}
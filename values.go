@@ -0,0 +1,359 @@
+package isodates
+
+import "time"
+
+/*
+ * The value types give you a way to hang on to a parsed date/week/month without committing to a
+ * time zone. You parse once, pass the value around your app (or store it in a struct), and only
+ * turn it into actual date/times with Start(loc)/End(loc) when you need them. The ParseXyzStart/End
+ * functions are just shortcuts for parsing a value and calling those methods.
+ */
+
+// Date is an ISO year-month-day such as "2019-05-22" with no time or time zone attached to it.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// ParseDateValue accepts an ISO-formatted year-month-day string (e.g. "2019-05-22") and returns the
+// Date that it represents. It follows the same rules as ParseDate.
+func ParseDateValue(input string) (Date, error) {
+	year, month, day, err := ParseDate(input)
+	if err != nil {
+		return Date{}, err
+	}
+	return Date{Year: year, Month: month, Day: day}, nil
+}
+
+// String returns the ISO representation of the date (e.g. "2019-05-22").
+func (date Date) String() string {
+	return ExtendedFormat.date(date.Year, date.Month, date.Day)
+}
+
+// Start returns midnight on this date in the given time zone. Just like time.Date(), the location
+// must not be nil.
+func (date Date) Start(loc *time.Location) time.Time {
+	return Midnight(date.Year, date.Month, date.Day, loc)
+}
+
+// End returns 11:59:59pm (one EndPrecision before midnight) on this date in the given time zone.
+func (date Date) End(loc *time.Location) time.Time {
+	return AlmostMidnight(date.Year, date.Month, date.Day, loc)
+}
+
+// Range returns the range from Start() through End() in the given time zone.
+func (date Date) Range(loc *time.Location) Range {
+	return Range{Start: date.Start(loc), End: date.End(loc)}
+}
+
+// Before returns true if this date comes before the other one.
+func (date Date) Before(other Date) bool {
+	return date.compare(other) < 0
+}
+
+// After returns true if this date comes after the other one.
+func (date Date) After(other Date) bool {
+	return date.compare(other) > 0
+}
+
+// Equal returns true if both values are the same date.
+func (date Date) Equal(other Date) bool {
+	return date.compare(other) == 0
+}
+
+// IsZero returns true if this is the zero value (i.e. it was never set/parsed).
+func (date Date) IsZero() bool {
+	return date == Date{}
+}
+
+func (date Date) compare(other Date) int {
+	return compareComponents(
+		[]int{date.Year, int(date.Month), date.Day},
+		[]int{other.Year, int(other.Month), other.Day},
+	)
+}
+
+// Week is an ISO year/week such as "2019-W05". The Year is the ISO week-numbering year, which may
+// differ from the calendar year of the week's first or last few days.
+type Week struct {
+	Year int
+	Week int
+}
+
+// ParseWeekValue accepts an ISO-formatted year/week string (e.g. "2019-W05") and returns the Week that
+// it represents. It follows the same rules as ParseWeek.
+func ParseWeekValue(input string) (Week, error) {
+	year, week, err := ParseWeek(input)
+	if err != nil {
+		return Week{}, err
+	}
+	return Week{Year: year, Week: week}, nil
+}
+
+// String returns the ISO representation of the week (e.g. "2019-W05").
+func (week Week) String() string {
+	return ExtendedFormat.week(week.Year, week.Week)
+}
+
+// Start returns midnight on the Monday that begins this week in the given time zone. Just like
+// time.Date(), the location must not be nil.
+func (week Week) Start(loc *time.Location) time.Time {
+	year, month, day := ISOWeeks.StartDate(week.Year, week.Week)
+	return Midnight(year, month, day, loc)
+}
+
+// End returns 11:59:59pm on the Sunday that ends this week in the given time zone.
+func (week Week) End(loc *time.Location) time.Time {
+	year, month, day := ISOWeeks.StartDate(week.Year, week.Week)
+	return AlmostMidnight(year, month, day+6, loc)
+}
+
+// Range returns the range from Start() through End() in the given time zone.
+func (week Week) Range(loc *time.Location) Range {
+	return Range{Start: week.Start(loc), End: week.End(loc)}
+}
+
+// Before returns true if this week comes before the other one.
+func (week Week) Before(other Week) bool {
+	return week.compare(other) < 0
+}
+
+// After returns true if this week comes after the other one.
+func (week Week) After(other Week) bool {
+	return week.compare(other) > 0
+}
+
+// Equal returns true if both values are the same week.
+func (week Week) Equal(other Week) bool {
+	return week.compare(other) == 0
+}
+
+// IsZero returns true if this is the zero value (i.e. it was never set/parsed).
+func (week Week) IsZero() bool {
+	return week == Week{}
+}
+
+func (week Week) compare(other Week) int {
+	return compareComponents([]int{week.Year, week.Week}, []int{other.Year, other.Week})
+}
+
+// WeekDay is an ISO year/week/day such as "2019-W05-3". The Day is the day of the week from
+// 1 (Monday) through 7 (Sunday).
+type WeekDay struct {
+	Year int
+	Week int
+	Day  int
+}
+
+// ParseWeekDayValue accepts an ISO-formatted year/week/day string (e.g. "2019-W05-3") and returns the
+// WeekDay that it represents. It follows the same rules as ParseWeekDay.
+func ParseWeekDayValue(input string) (WeekDay, error) {
+	year, week, day, err := ParseWeekDay(input)
+	if err != nil {
+		return WeekDay{}, err
+	}
+	return WeekDay{Year: year, Week: week, Day: day}, nil
+}
+
+// String returns the ISO representation of the week/day (e.g. "2019-W05-3").
+func (weekDay WeekDay) String() string {
+	return ExtendedFormat.weekDay(weekDay.Year, weekDay.Week, weekDay.Day)
+}
+
+// Start returns midnight on this day in the given time zone. Just like time.Date(), the location
+// must not be nil.
+func (weekDay WeekDay) Start(loc *time.Location) time.Time {
+	year, month, day := weekDay.date()
+	return Midnight(year, month, day, loc)
+}
+
+// End returns 11:59:59pm on this day in the given time zone.
+func (weekDay WeekDay) End(loc *time.Location) time.Time {
+	year, month, day := weekDay.date()
+	return AlmostMidnight(year, month, day, loc)
+}
+
+// Range returns the range from Start() through End() in the given time zone.
+func (weekDay WeekDay) Range(loc *time.Location) Range {
+	return Range{Start: weekDay.Start(loc), End: weekDay.End(loc)}
+}
+
+// Before returns true if this day comes before the other one.
+func (weekDay WeekDay) Before(other WeekDay) bool {
+	return weekDay.compare(other) < 0
+}
+
+// After returns true if this day comes after the other one.
+func (weekDay WeekDay) After(other WeekDay) bool {
+	return weekDay.compare(other) > 0
+}
+
+// Equal returns true if both values are the same day.
+func (weekDay WeekDay) Equal(other WeekDay) bool {
+	return weekDay.compare(other) == 0
+}
+
+// IsZero returns true if this is the zero value (i.e. it was never set/parsed).
+func (weekDay WeekDay) IsZero() bool {
+	return weekDay == WeekDay{}
+}
+
+// date returns the calendar date of this day. It's not normalized, so the day of the month may
+// run past the end of the month; Midnight() and AlmostMidnight() take care of that for us.
+func (weekDay WeekDay) date() (int, time.Month, int) {
+	year, month, day := ISOWeeks.StartDate(weekDay.Year, weekDay.Week)
+	return year, month, day + weekDay.Day - 1
+}
+
+func (weekDay WeekDay) compare(other WeekDay) int {
+	return compareComponents(
+		[]int{weekDay.Year, weekDay.Week, weekDay.Day},
+		[]int{other.Year, other.Week, other.Day},
+	)
+}
+
+// YearMonth is an ISO year/month such as "2019-05".
+type YearMonth struct {
+	Year  int
+	Month time.Month
+}
+
+// ParseYearMonthValue accepts an ISO-formatted year/month string (e.g. "2019-05" or "+2019-05") and
+// returns the YearMonth that it represents. It follows the same rules as ParseYearMonth.
+func ParseYearMonthValue(input string) (YearMonth, error) {
+	year, month, err := ParseYearMonth(input)
+	if err != nil {
+		return YearMonth{}, err
+	}
+	return YearMonth{Year: year, Month: month}, nil
+}
+
+// String returns the ISO representation of the year/month (e.g. "2019-05").
+func (yearMonth YearMonth) String() string {
+	return ExtendedFormat.yearMonth(yearMonth.Year, yearMonth.Month)
+}
+
+// Start returns midnight on the first day of the month in the given time zone. Just like time.Date(),
+// the location must not be nil.
+func (yearMonth YearMonth) Start(loc *time.Location) time.Time {
+	return Midnight(yearMonth.Year, yearMonth.Month, 1, loc)
+}
+
+// End returns 11:59:59pm on the last day of the month in the given time zone.
+func (yearMonth YearMonth) End(loc *time.Location) time.Time {
+	return AlmostMidnight(yearMonth.Year, yearMonth.Month+1, 0, loc)
+}
+
+// Range returns the range from Start() through End() in the given time zone.
+func (yearMonth YearMonth) Range(loc *time.Location) Range {
+	return Range{Start: yearMonth.Start(loc), End: yearMonth.End(loc)}
+}
+
+// Before returns true if this month comes before the other one.
+func (yearMonth YearMonth) Before(other YearMonth) bool {
+	return yearMonth.compare(other) < 0
+}
+
+// After returns true if this month comes after the other one.
+func (yearMonth YearMonth) After(other YearMonth) bool {
+	return yearMonth.compare(other) > 0
+}
+
+// Equal returns true if both values are the same month.
+func (yearMonth YearMonth) Equal(other YearMonth) bool {
+	return yearMonth.compare(other) == 0
+}
+
+// IsZero returns true if this is the zero value (i.e. it was never set/parsed).
+func (yearMonth YearMonth) IsZero() bool {
+	return yearMonth == YearMonth{}
+}
+
+func (yearMonth YearMonth) compare(other YearMonth) int {
+	return compareComponents(
+		[]int{yearMonth.Year, int(yearMonth.Month)},
+		[]int{other.Year, int(other.Month)},
+	)
+}
+
+// MonthDay is an ISO month/day with no year such as "--05-22". Since it has no year, you need to
+// supply one to get its Start/End.
+type MonthDay struct {
+	Month time.Month
+	Day   int
+}
+
+// ParseMonthDayValue accepts an ISO-formatted month/day string (e.g. "--05-22") and returns the
+// MonthDay that it represents. It follows the same rules as ParseMonthDay.
+func ParseMonthDayValue(input string) (MonthDay, error) {
+	month, day, err := ParseMonthDay(input)
+	if err != nil {
+		return MonthDay{}, err
+	}
+	return MonthDay{Month: month, Day: day}, nil
+}
+
+// String returns the ISO representation of the month/day (e.g. "--05-22").
+func (monthDay MonthDay) String() string {
+	return ExtendedFormat.monthDay(monthDay.Month, monthDay.Day)
+}
+
+// Start returns midnight on this month/day in the given year and time zone. Feb 29th moves to March 1st
+// in non-leap years; use a LeapDayPolicy if you want something else. Just like time.Date(), the location
+// must not be nil.
+func (monthDay MonthDay) Start(year int, loc *time.Location) time.Time {
+	return Midnight(year, monthDay.Month, monthDay.Day, loc)
+}
+
+// End returns 11:59:59pm on this month/day in the given year and time zone. Feb 29th moves to March 1st
+// in non-leap years; use a LeapDayPolicy if you want something else.
+func (monthDay MonthDay) End(year int, loc *time.Location) time.Time {
+	return AlmostMidnight(year, monthDay.Month, monthDay.Day, loc)
+}
+
+// Range returns the range from Start() through End() in the given year and time zone.
+func (monthDay MonthDay) Range(year int, loc *time.Location) Range {
+	return Range{Start: monthDay.Start(year, loc), End: monthDay.End(year, loc)}
+}
+
+// Before returns true if this month/day comes earlier in the year than the other one.
+func (monthDay MonthDay) Before(other MonthDay) bool {
+	return monthDay.compare(other) < 0
+}
+
+// After returns true if this month/day comes later in the year than the other one.
+func (monthDay MonthDay) After(other MonthDay) bool {
+	return monthDay.compare(other) > 0
+}
+
+// Equal returns true if both values are the same month/day.
+func (monthDay MonthDay) Equal(other MonthDay) bool {
+	return monthDay.compare(other) == 0
+}
+
+// IsZero returns true if this is the zero value (i.e. it was never set/parsed).
+func (monthDay MonthDay) IsZero() bool {
+	return monthDay == MonthDay{}
+}
+
+func (monthDay MonthDay) compare(other MonthDay) int {
+	return compareComponents(
+		[]int{int(monthDay.Month), monthDay.Day},
+		[]int{int(other.Month), other.Day},
+	)
+}
+
+// compareComponents compares two values one component at a time, from most to least significant. It
+// returns -1, 0, or 1 just like strings.Compare().
+func compareComponents(a []int, b []int) int {
+	for i := range a {
+		switch {
+		case a[i] < b[i]:
+			return -1
+		case a[i] > b[i]:
+			return 1
+		}
+	}
+	return 0
+}
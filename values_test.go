@@ -0,0 +1,198 @@
+package isodates_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestValuesSuite(t *testing.T) {
+	suite.Run(t, new(ValuesSuite))
+}
+
+type ValuesSuite struct {
+	ChronoSuite
+}
+
+func (suite *ValuesSuite) TestDate() {
+	succeeds := func(input string, expected isodates.Date) {
+		actual, err := isodates.ParseDateValue(input)
+		_ = suite.NoError(err) &&
+			suite.Equal(expected, actual) &&
+			suite.Equal(input, actual.String())
+	}
+	fails := func(input string) {
+		actual, err := isodates.ParseDateValue(input)
+		_ = suite.Error(err) && suite.True(actual.IsZero())
+	}
+	succeeds("2019-05-22", isodates.Date{Year: 2019, Month: time.May, Day: 22})
+	succeeds("2020-02-29", isodates.Date{Year: 2020, Month: time.February, Day: 29})
+	succeeds("0001-01-01", isodates.Date{Year: 1, Month: time.January, Day: 1})
+	fails("")
+	fails("2019-02-29")
+	fails("2019-W05")
+
+	date := isodates.Date{Year: 2019, Month: time.May, Day: 22}
+	suite.AssertMidnightIn(date.Start(locationEDT), nil, 2019, time.May, 22, locationEDT)
+	suite.AssertAlmostMidnightIn(date.End(locationEDT), nil, 2019, time.May, 22, locationEDT)
+	suite.True(day(2019, time.May, 22).Equal(date.Range(time.UTC)))
+	suite.False(date.IsZero())
+	suite.True(isodates.Date{}.IsZero())
+	suite.Equal("-0044-03-15", isodates.Date{Year: -44, Month: time.March, Day: 15}.String())
+	suite.Equal("+10000-01-01", isodates.Date{Year: 10000, Month: time.January, Day: 1}.String())
+}
+
+func (suite *ValuesSuite) TestDateComparison() {
+	date := isodates.Date{Year: 2019, Month: time.May, Day: 22}
+	earlier := []isodates.Date{
+		{Year: 2019, Month: time.May, Day: 21},
+		{Year: 2019, Month: time.April, Day: 30},
+		{Year: 2018, Month: time.December, Day: 31},
+	}
+	for _, other := range earlier {
+		suite.True(other.Before(date), other.String())
+		suite.False(other.After(date), other.String())
+		suite.False(other.Equal(date), other.String())
+		suite.True(date.After(other), other.String())
+	}
+	suite.True(date.Equal(isodates.Date{Year: 2019, Month: time.May, Day: 22}))
+	suite.False(date.Before(date))
+	suite.False(date.After(date))
+}
+
+func (suite *ValuesSuite) TestWeek() {
+	succeeds := func(input string, expected isodates.Week) {
+		actual, err := isodates.ParseWeekValue(input)
+		_ = suite.NoError(err) &&
+			suite.Equal(expected, actual) &&
+			suite.Equal(input, actual.String())
+	}
+	fails := func(input string) {
+		actual, err := isodates.ParseWeekValue(input)
+		_ = suite.Error(err) && suite.True(actual.IsZero())
+	}
+	succeeds("2019-W05", isodates.Week{Year: 2019, Week: 5})
+	succeeds("2020-W53", isodates.Week{Year: 2020, Week: 53})
+	fails("")
+	fails("2019-W53")
+	fails("2019-W05-3")
+
+	// Week 1 of 2019 starts on Monday Dec 31, 2018.
+	week := isodates.Week{Year: 2019, Week: 1}
+	suite.AssertMidnightIn(week.Start(locationPDT), nil, 2018, time.December, 31, locationPDT)
+	suite.AssertAlmostMidnightIn(week.End(locationPDT), nil, 2019, time.January, 6, locationPDT)
+	suite.True(days(2018, time.December, 31, 2019, time.January, 6).Equal(week.Range(time.UTC)))
+
+	suite.True(isodates.Week{Year: 2020, Week: 53}.Before(isodates.Week{Year: 2021, Week: 1}))
+	suite.True(isodates.Week{Year: 2019, Week: 6}.After(isodates.Week{Year: 2019, Week: 5}))
+	suite.True(week.Equal(isodates.Week{Year: 2019, Week: 1}))
+	suite.False(week.IsZero())
+}
+
+func (suite *ValuesSuite) TestWeekDay() {
+	succeeds := func(input string, expected isodates.WeekDay) {
+		actual, err := isodates.ParseWeekDayValue(input)
+		_ = suite.NoError(err) &&
+			suite.Equal(expected, actual) &&
+			suite.Equal(input, actual.String())
+	}
+	fails := func(input string) {
+		actual, err := isodates.ParseWeekDayValue(input)
+		_ = suite.Error(err) && suite.True(actual.IsZero())
+	}
+	succeeds("2019-W05-3", isodates.WeekDay{Year: 2019, Week: 5, Day: 3})
+	succeeds("2020-W53-7", isodates.WeekDay{Year: 2020, Week: 53, Day: 7})
+	fails("")
+	fails("2019-W05-8")
+	fails("2019-W05")
+
+	// Sunday of 2020-W53 is January 3, 2021.
+	weekDay := isodates.WeekDay{Year: 2020, Week: 53, Day: 7}
+	suite.AssertMidnightIn(weekDay.Start(locationEDT), nil, 2021, time.January, 3, locationEDT)
+	suite.AssertAlmostMidnightIn(weekDay.End(locationEDT), nil, 2021, time.January, 3, locationEDT)
+	suite.True(day(2021, time.January, 3).Equal(weekDay.Range(time.UTC)))
+
+	suite.True(isodates.WeekDay{Year: 2019, Week: 5, Day: 7}.Before(isodates.WeekDay{Year: 2019, Week: 6, Day: 1}))
+	suite.True(isodates.WeekDay{Year: 2019, Week: 5, Day: 4}.After(isodates.WeekDay{Year: 2019, Week: 5, Day: 3}))
+	suite.True(weekDay.Equal(isodates.WeekDay{Year: 2020, Week: 53, Day: 7}))
+	suite.True(isodates.WeekDay{}.IsZero())
+}
+
+func (suite *ValuesSuite) TestYearMonth() {
+	succeeds := func(input string, expected isodates.YearMonth, canonical string) {
+		actual, err := isodates.ParseYearMonthValue(input)
+		_ = suite.NoError(err) &&
+			suite.Equal(expected, actual) &&
+			suite.Equal(canonical, actual.String())
+	}
+	fails := func(input string) {
+		actual, err := isodates.ParseYearMonthValue(input)
+		_ = suite.Error(err) && suite.True(actual.IsZero())
+	}
+	succeeds("2019-05", isodates.YearMonth{Year: 2019, Month: time.May}, "2019-05")
+	succeeds("+2019-05", isodates.YearMonth{Year: 2019, Month: time.May}, "2019-05")
+	succeeds("-2019-05", isodates.YearMonth{Year: -2019, Month: time.May}, "-2019-05")
+	fails("")
+	fails("2019-13")
+	fails("2019-05-22")
+
+	yearMonth := isodates.YearMonth{Year: 2020, Month: time.February}
+	suite.AssertMidnightIn(yearMonth.Start(locationEDT), nil, 2020, time.February, 1, locationEDT)
+	suite.AssertAlmostMidnightIn(yearMonth.End(locationEDT), nil, 2020, time.February, 29, locationEDT)
+	suite.True(days(2020, time.February, 1, 2020, time.February, 29).Equal(yearMonth.Range(time.UTC)))
+
+	suite.True(isodates.YearMonth{Year: 2019, Month: time.December}.Before(yearMonth))
+	suite.True(isodates.YearMonth{Year: 2020, Month: time.March}.After(yearMonth))
+	suite.True(yearMonth.Equal(isodates.YearMonth{Year: 2020, Month: time.February}))
+	suite.False(yearMonth.IsZero())
+}
+
+func (suite *ValuesSuite) TestMonthDay() {
+	succeeds := func(input string, expected isodates.MonthDay, canonical string) {
+		actual, err := isodates.ParseMonthDayValue(input)
+		_ = suite.NoError(err) &&
+			suite.Equal(expected, actual) &&
+			suite.Equal(canonical, actual.String())
+	}
+	fails := func(input string) {
+		actual, err := isodates.ParseMonthDayValue(input)
+		_ = suite.Error(err) && suite.True(actual.IsZero())
+	}
+	succeeds("--05-22", isodates.MonthDay{Month: time.May, Day: 22}, "--05-22")
+	succeeds("--5-2", isodates.MonthDay{Month: time.May, Day: 2}, "--05-02")
+	succeeds("--02-29", isodates.MonthDay{Month: time.February, Day: 29}, "--02-29")
+	fails("")
+	fails("--02-30")
+	fails("2019-05-22")
+
+	monthDay := isodates.MonthDay{Month: time.February, Day: 29}
+	suite.AssertMidnightIn(monthDay.Start(2020, locationEDT), nil, 2020, time.February, 29, locationEDT)
+	suite.AssertAlmostMidnightIn(monthDay.End(2020, locationEDT), nil, 2020, time.February, 29, locationEDT)
+	suite.AssertMidnightIn(monthDay.Start(2019, locationEDT), nil, 2019, time.March, 1, locationEDT)
+	suite.True(day(2019, time.March, 1).Equal(monthDay.Range(2019, time.UTC)))
+
+	suite.True(isodates.MonthDay{Month: time.January, Day: 31}.Before(monthDay))
+	suite.True(isodates.MonthDay{Month: time.March, Day: 1}.After(monthDay))
+	suite.True(monthDay.Equal(isodates.MonthDay{Month: time.February, Day: 29}))
+	suite.True(isodates.MonthDay{}.IsZero())
+}
+
+func ExampleParseWeekValue() {
+	week, err := isodates.ParseWeekValue("2019-W05")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(week)
+	fmt.Println(week.Start(time.UTC))
+	fmt.Println(week.End(time.UTC))
+	fmt.Println(week.Before(isodates.Week{Year: 2019, Week: 6}))
+
+	// Output:
+	// 2019-W05
+	// 2019-01-28 00:00:00 +0000 UTC
+	// 2019-02-03 23:59:59.999999999 +0000 UTC
+	// true
+}
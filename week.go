@@ -1,36 +1,48 @@
 package isodates
 
 import (
-	"errors"
+	"fmt"
+	"strings"
 	"time"
-
-	"github.com/snabb/isoweek"
 )
 
-/*
- * We rely heavily on github.com/snabb/isoweek for this parsing. There are a ton of algorithms
- * of varying complexity and performance. His library is already amazing, so no need to
- * reinvent the wheel.
- */
-
 // ParseWeek accepts an ISO-formatted year/week string (e.g. "2019-W04") and returns the
-// year and week number that it represents.
+// year and week number that it represents. Week 53 is only valid in ISO years that actually
+// have 53 weeks (see WeeksInYear).
 func ParseWeek(input string) (year int, week int, err error) {
-	if len(input) != 8 {
-		return 0, 0, invalidFormat("YYYY-W##", input)
-	}
-	if input[4:6] != "-W" {
-		return 0, 0, invalidFormat("YYYY-W##", input)
-	}
+	return ISOWeeks.ParseWeek(input)
+}
+
+// WeeksInYear returns the number of ISO weeks (52 or 53) in the given ISO week-numbering year.
+func WeeksInYear(year int) int {
+	weeks, _ := ISOWeeks.WeeksInYear(year)
+	return weeks
+}
 
-	yearText := input[0:4]
-	weekText := input[6:]
+// parseWeekFormat extracts the year and week number from a "YYYY-W##" string (or its basic/expanded
+// forms such as "2019W05" or "+002019-W05"). It only checks that the week is between 1 and 53, so you
+// still need to check it against a specific year.
+func parseWeekFormat(input string) (year int, week int, err error) {
+	layout := weekLayout(input)
+	if err = layout.checkLength(input); err != nil {
+		return 0, 0, err
+	}
+	return parseYearWeek(layout, input)
+}
 
-	year, err = parseYear(yearText)
+// parseYearWeek extracts the year and week number from the "YYYY-W##" at the start of the input. The
+// input must be long enough to hold the layout, but it can have more (e.g. the day in "YYYY-W##-#").
+func parseYearWeek(layout yearLayout, input string) (year int, week int, err error) {
+	literal := layout.separator() + "W"
+	if err = checkLiteral(layout.format, input, layout.yearEnd, literal); err != nil {
+		return 0, 0, err
+	}
+	year, err = layout.parseYear(input)
 	if err != nil {
 		return 0, 0, err
 	}
-	week, err = parseWeek(weekText)
+	weekStart := layout.yearEnd + len(literal)
+	week, err = parseWeek(layout.format, input, weekStart, weekStart+2)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -47,35 +59,74 @@ func ParseWeekStart(input string) (time.Time, error) {
 // local time of the specified location.
 func ParseWeekStartIn(input string, loc *time.Location) (time.Time, error) {
 	if loc == nil {
-		return ZeroTime, errors.New("parse week start: nil location")
+		return ZeroTime, fmt.Errorf("parse week start: %w", ErrNilLocation)
 	}
-	isoYear, isoWeek, err := ParseWeek(input)
+	week, err := ParseWeekValue(input)
 	if err != nil {
 		return ZeroTime, err
 	}
-
-	year, month, day := isoweek.StartDate(isoYear, isoWeek)
-	return Midnight(year, month, day, loc), nil
+	return week.Start(loc), nil
 }
 
-// ParseWeekEnd returns 11:59:59pm (one nanosecond before midnight) on Sunday of the specified ISO week
+// ParseWeekEnd returns 11:59:59pm (one EndPrecision before midnight) on Sunday of the specified ISO week
 // string. The resulting date/time will be in UTC. If you would like this to be almost-midnight of some local
 // time, use ParseWeekEndIn.
 func ParseWeekEnd(input string) (time.Time, error) {
 	return ParseWeekEndIn(input, time.UTC)
 }
 
-// ParseWeekEndIn returns 11:59:59pm (one nanosecond before midnight) on Sunday of the specified ISO week
+// ParseWeekEndIn returns 11:59:59pm (one EndPrecision before midnight) on Sunday of the specified ISO week
 // string. This will be in the local time of the specified location.
 func ParseWeekEndIn(input string, loc *time.Location) (time.Time, error) {
 	if loc == nil {
-		return ZeroTime, errors.New("parse week end: nil location")
+		return ZeroTime, fmt.Errorf("parse week end: %w", ErrNilLocation)
 	}
-	isoYear, isoWeek, err := ParseWeek(input)
+	week, err := ParseWeekValue(input)
 	if err != nil {
 		return ZeroTime, err
 	}
+	return week.End(loc), nil
+}
+
+// ParseWeekEndExclusive returns midnight on the Monday after the specified ISO week string, which is
+// the exclusive end of the week (i.e. "ts < end"). The resulting date/time will be in UTC.
+func ParseWeekEndExclusive(input string) (time.Time, error) {
+	return ParseWeekEndExclusiveIn(input, time.UTC)
+}
+
+// ParseWeekEndExclusiveIn returns midnight on the Monday after the specified ISO week string. This will
+// be in the local time of the specified location.
+func ParseWeekEndExclusiveIn(input string, loc *time.Location) (time.Time, error) {
+	return exclusiveEnd(ParseWeekEndIn(input, loc))
+}
+
+// ParseWeekRange returns the range from midnight on Monday through 11:59:59pm on Sunday of the specified
+// ISO week string. The resulting range will be in UTC.
+func ParseWeekRange(input string) (Range, error) {
+	return ParseWeekRangeIn(input, time.UTC)
+}
+
+// ParseWeekRangeIn returns the range from midnight on Monday through 11:59:59pm on Sunday of the specified
+// ISO week string. This will be in the local time of the specified location.
+func ParseWeekRangeIn(input string, loc *time.Location) (Range, error) {
+	if loc == nil {
+		return Range{}, fmt.Errorf("parse week range: %w", ErrNilLocation)
+	}
+	week, err := ParseWeekValue(input)
+	if err != nil {
+		return Range{}, err
+	}
+	return week.Range(loc), nil
+}
+
+// weekLayout works out whether the input is an extended ("2019-W05") or basic ("2019W05") week and
+// where its year ends.
+func weekLayout(input string) yearLayout {
+	return layoutOf(input, "YYYY-W##", "YYYYW##")
+}
 
-	year, month, day := isoweek.StartDate(isoYear, isoWeek)
-	return AlmostMidnight(year, month, day+6, loc), nil
+// weekStart returns the offset of the week number in a week or week/day string, which always comes
+// right after the 'W'.
+func weekStart(input string) int {
+	return strings.LastIndexByte(input, 'W') + 1
 }
@@ -1,35 +1,44 @@
 package isodates
 
 import (
-	"errors"
+	"fmt"
 	"time"
-
-	"github.com/snabb/isoweek"
 )
 
 // ParseWeekDay extracts all 3 numeric components from an ISO Week-Day string (e.g. "2019-W02-3").
+// Week 53 is only valid in ISO years that actually have 53 weeks (see WeeksInYear).
 func ParseWeekDay(input string) (year int, weekNum int, day int, err error) {
-	if len(input) != 10 {
-		return 0, 0, 0, invalidFormat("YYYY-W##-#", input)
-	}
-	if input[4:6] != "-W" {
-		return 0, 0, 0, invalidFormat("YYYY-W##-#", input)
-	}
-	if input[8] != '-' {
-		return 0, 0, 0, invalidFormat("YYYY-W##-#", input)
-	}
+	return ISOWeeks.ParseWeekDay(input)
+}
 
-	year, weekNum, err = ParseWeek(input[0:8])
+// parseWeekDayFormat extracts all 3 numeric components from a "YYYY-W##-#" string (or its basic/expanded
+// forms such as "2019W053" or "+002019-W05-3"). Just like parseWeekFormat, it does not check the week
+// number against a specific year.
+func parseWeekDayFormat(input string) (year int, weekNum int, day int, err error) {
+	layout := weekDayLayout(input)
+	if err = layout.checkLength(input); err != nil {
+		return 0, 0, 0, err
+	}
+	year, weekNum, err = parseYearWeek(layout, input)
 	if err != nil {
 		return 0, 0, 0, err
 	}
-	day, err = parseWeekOffset(input[9:])
+	if err = checkLiteral(layout.format, input, len(input)-2, layout.separator()); err != nil {
+		return 0, 0, 0, err
+	}
+	day, err = parseWeekOffset(layout.format, input, len(input)-1, len(input))
 	if err != nil {
 		return 0, 0, 0, err
 	}
 	return year, weekNum, day, nil
 }
 
+// weekDayLayout works out whether the input is an extended ("2019-W05-3") or basic ("2019W053")
+// week/day and where its year ends.
+func weekDayLayout(input string) yearLayout {
+	return layoutOf(input, "YYYY-W##-#", "YYYYW###")
+}
+
 // ParseWeekDayStart accepts an ISO-formatted year/week/day string (e.g. "2019-W04-3") and returns the
 // exact date that it represents. The resulting date/time will be at midnight in UTC.
 func ParseWeekDayStart(input string) (time.Time, error) {
@@ -40,14 +49,13 @@ func ParseWeekDayStart(input string) (time.Time, error) {
 // exact date that it represents. The resulting date/time will be at midnight in the given time zone.
 func ParseWeekDayStartIn(input string, loc *time.Location) (time.Time, error) {
 	if loc == nil {
-		return ZeroTime, errors.New("parse week day start: nil location")
+		return ZeroTime, fmt.Errorf("parse week day start: %w", ErrNilLocation)
 	}
-	year, weekNum, day, err := ParseWeekDay(input)
+	weekDay, err := ParseWeekDayValue(input)
 	if err != nil {
 		return ZeroTime, err
 	}
-	startYear, startMonth, startDay := isoweek.StartDate(year, weekNum)
-	return Midnight(startYear, startMonth, startDay+day-1, loc), nil
+	return weekDay.Start(loc), nil
 }
 
 // ParseWeekDayEnd accepts an ISO-formatted year/week/day string (e.g. "2019-W04-3") and returns the
@@ -60,12 +68,42 @@ func ParseWeekDayEnd(input string) (time.Time, error) {
 // exact date that it represents. The resulting date/time will be at 11:59:59pm in the given time zone.
 func ParseWeekDayEndIn(input string, loc *time.Location) (time.Time, error) {
 	if loc == nil {
-		return ZeroTime, errors.New("parse week day end: nil location")
+		return ZeroTime, fmt.Errorf("parse week day end: %w", ErrNilLocation)
 	}
-	year, weekNum, day, err := ParseWeekDay(input)
+	weekDay, err := ParseWeekDayValue(input)
 	if err != nil {
 		return ZeroTime, err
 	}
-	startYear, startMonth, startDay := isoweek.StartDate(year, weekNum)
-	return AlmostMidnight(startYear, startMonth, startDay+day-1, loc), nil
+	return weekDay.End(loc), nil
+}
+
+// ParseWeekDayEndExclusive accepts an ISO-formatted year/week/day string (e.g. "2019-W04-3") and
+// returns midnight at the start of the following day. The resulting date/time will be in UTC.
+func ParseWeekDayEndExclusive(input string) (time.Time, error) {
+	return ParseWeekDayEndExclusiveIn(input, time.UTC)
+}
+
+// ParseWeekDayEndExclusiveIn accepts an ISO-formatted year/week/day string (e.g. "2019-W04-3") and
+// returns midnight at the start of the following day in the given time zone.
+func ParseWeekDayEndExclusiveIn(input string, loc *time.Location) (time.Time, error) {
+	return exclusiveEnd(ParseWeekDayEndIn(input, loc))
+}
+
+// ParseWeekDayRange accepts an ISO-formatted year/week/day string (e.g. "2019-W04-3") and returns the
+// range from midnight through 11:59:59pm on the date that it represents in UTC.
+func ParseWeekDayRange(input string) (Range, error) {
+	return ParseWeekDayRangeIn(input, time.UTC)
+}
+
+// ParseWeekDayRangeIn accepts an ISO-formatted year/week/day string (e.g. "2019-W04-3") and returns the
+// range from midnight through 11:59:59pm on the date that it represents in the given time zone.
+func ParseWeekDayRangeIn(input string, loc *time.Location) (Range, error) {
+	if loc == nil {
+		return Range{}, fmt.Errorf("parse week day range: %w", ErrNilLocation)
+	}
+	weekDay, err := ParseWeekDayValue(input)
+	if err != nil {
+		return Range{}, err
+	}
+	return weekDay.Range(loc), nil
 }
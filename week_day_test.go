@@ -42,6 +42,7 @@ func (suite *WeekDaySuite) TestParseWeekDay() {
 
 	// Invalid weeks
 	fails("2019-W-1")
+	fails("2019-W53-1") // 2019 only has 52 ISO weeks
 	fails("2019-W73-1")
 	fails("2019-W00-3")
 	fails("2019-WJ4-4")
@@ -59,6 +60,14 @@ func (suite *WeekDaySuite) TestParseWeekDay() {
 	fails("1234-W4-1")
 	fails("1234-W4-03") // day offset shouldn't be padded
 
+	// Basic format and expanded years
+	fails("2019W0531")
+	fails("2019-W053")
+	fails("2019W05-3")
+	fails("2019W530") // 2019 only has 52 ISO weeks
+	fails("+201-W05-3")
+	fails("+-019W053")
+
 	succeeds("2019-W01-1", 2019, 1, 1)
 	succeeds("2019-W01-2", 2019, 1, 2)
 	succeeds("2019-W01-3", 2019, 1, 3)
@@ -90,6 +99,12 @@ func (suite *WeekDaySuite) TestParseWeekDay() {
 	succeeds("2004-W53-5", 2004, 53, 5)
 	succeeds("2004-W53-6", 2004, 53, 6)
 	succeeds("2004-W53-7", 2004, 53, 7)
+
+	succeeds("2019W053", 2019, 5, 3)
+	succeeds("2004W537", 2004, 53, 7)
+	succeeds("+002019-W05-3", 2019, 5, 3)
+	succeeds("+002019W053", 2019, 5, 3)
+	succeeds("-0044-W11-7", -44, 11, 7)
 }
 
 func (suite *WeekDaySuite) TestParseWeekDayStart() {
@@ -131,6 +146,14 @@ func (suite *WeekDaySuite) TestParseWeekDayStart() {
 	fails("1234-W4-1")
 	fails("1234-W4-03") // day offset shouldn't be padded
 
+	// Basic format and expanded years
+	fails("2019W0531")
+	fails("2019-W053")
+	fails("2019W05-3")
+	fails("2019W530") // 2019 only has 52 ISO weeks
+	fails("+201-W05-3")
+	fails("+-019W053")
+
 	succeeds("2019-W01-1", 2018, time.December, 31)
 	succeeds("2019-W01-2", 2019, time.January, 1)
 	succeeds("2019-W01-3", 2019, time.January, 2)
@@ -267,6 +290,14 @@ func (suite *WeekDaySuite) TestParseWeekDayEnd() {
 	fails("1234-W4-1")
 	fails("1234-W4-03") // day offset shouldn't be padded
 
+	// Basic format and expanded years
+	fails("2019W0531")
+	fails("2019-W053")
+	fails("2019W05-3")
+	fails("2019W530") // 2019 only has 52 ISO weeks
+	fails("+201-W05-3")
+	fails("+-019W053")
+
 	succeeds("2019-W01-1", 2018, time.December, 31)
 	succeeds("2019-W01-2", 2019, time.January, 1)
 	succeeds("2019-W01-3", 2019, time.January, 2)
@@ -364,6 +395,47 @@ func (suite *WeekDaySuite) TestParseWeekDayEndIn() {
 	succeeds("2004-W53-7", 2005, time.January, 2, locationPDT)
 }
 
+func (suite *WeekDaySuite) TestParseWeekDayEndExclusiveIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := isodates.ParseWeekDayEndExclusiveIn(input, loc)
+		suite.AssertMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.ParseWeekDayEndExclusiveIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", time.UTC)
+	fails("2019-W05-8", time.UTC)
+	fails("2019-W05-3", nil)
+
+	succeeds("2019-W05-3", time.UTC, 2019, time.January, 31)
+	succeeds("2019-W05-7", locationEDT, 2019, time.February, 4)
+
+	date, err := isodates.ParseWeekDayEndExclusive("2019-W05-3")
+	suite.AssertMidnightUTC(date, err, 2019, time.January, 31)
+}
+
+func (suite *WeekDaySuite) TestParseWeekDayRangeIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		r, err := isodates.ParseWeekDayRangeIn(input, loc)
+		suite.AssertRangeIn(r, err, year, month, day, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.ParseWeekDayRangeIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", time.UTC)
+	fails("2019-W05-8", time.UTC)
+	fails("2019-W05-3", nil)
+
+	succeeds("2019-W05-3", time.UTC, 2019, time.January, 30)
+	succeeds("2019-W01-1", locationEDT, 2018, time.December, 31)
+	succeeds("2019-W05-7", locationPDT, 2019, time.February, 3)
+
+	r, err := isodates.ParseWeekDayRange("2019-W05-3")
+	suite.AssertRangeIn(r, err, 2019, time.January, 30, 2019, time.January, 30, time.UTC)
+}
+
 func ExampleParseWeekDay() {
 	date, err := isodates.ParseWeekDayStart("2019-W02-2")
 	if err != nil {
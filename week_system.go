@@ -0,0 +1,294 @@
+package isodates
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/snabb/isoweek"
+)
+
+/*
+ * We rely heavily on github.com/snabb/isoweek for the day-number math behind week parsing. There
+ * are a ton of algorithms of varying complexity and performance. His library is already amazing,
+ * so no need to reinvent the wheel.
+ */
+
+var (
+	// ISOWeeks is the ISO 8601 week system where weeks start on Monday and week 1 is the first week
+	// with at least 4 days in the new year (i.e. the week containing January 4th). This is the
+	// system used by the package-level week functions such as ParseWeekStart().
+	ISOWeeks = WeekSystem{FirstWeekday: time.Monday, MinDaysInFirstWeek: 4}
+	// USWeeks is the week system commonly used in the US where weeks start on Sunday and week 1 is
+	// the week containing January 1st.
+	USWeeks = WeekSystem{FirstWeekday: time.Sunday, MinDaysInFirstWeek: 1}
+	// MMWRWeeks is the CDC's Morbidity and Mortality Weekly Report (epidemiological) week system
+	// where weeks start on Sunday and week 1 is the first week with at least 4 days in the new year.
+	MMWRWeeks = WeekSystem{FirstWeekday: time.Sunday, MinDaysInFirstWeek: 4}
+	// MiddleEastWeeks is the week system used in much of the Middle East where weeks start on
+	// Saturday and week 1 is the week containing January 1st.
+	MiddleEastWeeks = WeekSystem{FirstWeekday: time.Saturday, MinDaysInFirstWeek: 1}
+)
+
+// WeekSystem describes the rules used to number the weeks of a year. Each week begins on the same
+// day of the week, and week 1 is the first week that has at least some minimum number of days in
+// the new year. The days at the start of January that come before week 1 belong to the last week
+// of the previous year.
+//
+// All of the week Start/End functions are available as methods on a WeekSystem, so you can use
+// the same "YYYY-W##" and "YYYY-W##-#" strings with non-ISO weeks. Day offsets are relative to the
+// system's first weekday, so in US weeks "2019-W05-1" is a Sunday.
+type WeekSystem struct {
+	// FirstWeekday is the day of the week that every week begins on.
+	FirstWeekday time.Weekday
+	// MinDaysInFirstWeek is the minimum number of days (1-7) that week 1 must have in the new year.
+	MinDaysInFirstWeek int
+}
+
+// StartDate returns the date of the first day of the given week.
+func (system WeekSystem) StartDate(weekYear int, week int) (year int, month time.Month, day int) {
+	shift := gregorianShift(weekYear)
+	year, month, day = isoweek.JulianToDate(system.weekOneStart(weekYear+shift) + (week-1)*7)
+	return year - shift, month, day
+}
+
+// FromDate returns the week-numbering year and week number that the given date falls in. The
+// week-numbering year may differ from the calendar year for dates at the very start or end of the year.
+func (system WeekSystem) FromDate(year int, month time.Month, day int) (weekYear int, week int) {
+	shift := gregorianShift(year)
+	dayNo := isoweek.DateToJulian(year+shift, month, day)
+	weekYear = year + shift
+	if dayNo < system.weekOneStart(weekYear) {
+		weekYear--
+	} else if dayNo >= system.weekOneStart(weekYear+1) {
+		weekYear++
+	}
+	return weekYear - shift, (dayNo-system.weekOneStart(weekYear))/7 + 1
+}
+
+// WeeksInYear returns the number of weeks (52 or 53) in the given week-numbering year.
+func (system WeekSystem) WeeksInYear(year int) (int, error) {
+	if err := system.validate(); err != nil {
+		return 0, err
+	}
+	year += gregorianShift(year)
+	return (system.weekOneStart(year+1) - system.weekOneStart(year)) / 7, nil
+}
+
+// ParseWeek accepts a year/week string (e.g. "2019-W04") and returns the year and week number that
+// it represents. Week 53 is only valid in years that have 53 weeks in this system.
+func (system WeekSystem) ParseWeek(input string) (year int, week int, err error) {
+	year, week, err = parseWeekFormat(input)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err = system.validateWeek(weekLayout(input).format, input, year, week); err != nil {
+		return 0, 0, err
+	}
+	return year, week, nil
+}
+
+// ParseWeekDay extracts all 3 numeric components from a year/week/day string (e.g. "2019-W02-3").
+// Week 53 is only valid in years that have 53 weeks in this system.
+func (system WeekSystem) ParseWeekDay(input string) (year int, week int, day int, err error) {
+	year, week, day, err = parseWeekDayFormat(input)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if err = system.validateWeek(weekDayLayout(input).format, input, year, week); err != nil {
+		return 0, 0, 0, err
+	}
+	return year, week, day, nil
+}
+
+// ParseWeekStart returns midnight on the first day of the specified week string (e.g. "2019-W04").
+// The resulting date/time will be in UTC.
+func (system WeekSystem) ParseWeekStart(input string) (time.Time, error) {
+	return system.ParseWeekStartIn(input, time.UTC)
+}
+
+// ParseWeekStartIn returns midnight on the first day of the specified week string (e.g. "2019-W04").
+// This will be in the local time of the specified location.
+func (system WeekSystem) ParseWeekStartIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse week start: %w", ErrNilLocation)
+	}
+	weekYear, week, err := system.ParseWeek(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+
+	year, month, day := system.StartDate(weekYear, week)
+	return Midnight(year, month, day, loc), nil
+}
+
+// ParseWeekEnd returns 11:59:59pm on the last day of the specified week string (e.g. "2019-W04").
+// The resulting date/time will be in UTC.
+func (system WeekSystem) ParseWeekEnd(input string) (time.Time, error) {
+	return system.ParseWeekEndIn(input, time.UTC)
+}
+
+// ParseWeekEndIn returns 11:59:59pm on the last day of the specified week string (e.g. "2019-W04").
+// This will be in the local time of the specified location.
+func (system WeekSystem) ParseWeekEndIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse week end: %w", ErrNilLocation)
+	}
+	weekYear, week, err := system.ParseWeek(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+
+	year, month, day := system.StartDate(weekYear, week)
+	return AlmostMidnight(year, month, day+6, loc), nil
+}
+
+// ParseWeekEndExclusive returns midnight on the first day of the week after the specified week string
+// (e.g. "2019-W04"). The resulting date/time will be in UTC.
+func (system WeekSystem) ParseWeekEndExclusive(input string) (time.Time, error) {
+	return system.ParseWeekEndExclusiveIn(input, time.UTC)
+}
+
+// ParseWeekEndExclusiveIn returns midnight on the first day of the week after the specified week string
+// (e.g. "2019-W04"). This will be in the local time of the specified location.
+func (system WeekSystem) ParseWeekEndExclusiveIn(input string, loc *time.Location) (time.Time, error) {
+	return exclusiveEnd(system.ParseWeekEndIn(input, loc))
+}
+
+// ParseWeekDayStart accepts a year/week/day string (e.g. "2019-W04-3") and returns the exact date
+// that it represents. The resulting date/time will be at midnight in UTC.
+func (system WeekSystem) ParseWeekDayStart(input string) (time.Time, error) {
+	return system.ParseWeekDayStartIn(input, time.UTC)
+}
+
+// ParseWeekDayStartIn accepts a year/week/day string (e.g. "2019-W04-3") and returns the exact date
+// that it represents. The resulting date/time will be at midnight in the given time zone.
+func (system WeekSystem) ParseWeekDayStartIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse week day start: %w", ErrNilLocation)
+	}
+	weekYear, week, day, err := system.ParseWeekDay(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	startYear, startMonth, startDay := system.StartDate(weekYear, week)
+	return Midnight(startYear, startMonth, startDay+day-1, loc), nil
+}
+
+// ParseWeekDayEnd accepts a year/week/day string (e.g. "2019-W04-3") and returns the exact date
+// that it represents. The resulting date/time will be at 11:59:59pm in UTC.
+func (system WeekSystem) ParseWeekDayEnd(input string) (time.Time, error) {
+	return system.ParseWeekDayEndIn(input, time.UTC)
+}
+
+// ParseWeekDayEndIn accepts a year/week/day string (e.g. "2019-W04-3") and returns the exact date
+// that it represents. The resulting date/time will be at 11:59:59pm in the given time zone.
+func (system WeekSystem) ParseWeekDayEndIn(input string, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		return ZeroTime, fmt.Errorf("parse week day end: %w", ErrNilLocation)
+	}
+	weekYear, week, day, err := system.ParseWeekDay(input)
+	if err != nil {
+		return ZeroTime, err
+	}
+	startYear, startMonth, startDay := system.StartDate(weekYear, week)
+	return AlmostMidnight(startYear, startMonth, startDay+day-1, loc), nil
+}
+
+// ParseWeekDayEndExclusive accepts a year/week/day string (e.g. "2019-W04-3") and returns midnight at
+// the start of the following day. The resulting date/time will be in UTC.
+func (system WeekSystem) ParseWeekDayEndExclusive(input string) (time.Time, error) {
+	return system.ParseWeekDayEndExclusiveIn(input, time.UTC)
+}
+
+// ParseWeekDayEndExclusiveIn accepts a year/week/day string (e.g. "2019-W04-3") and returns midnight
+// at the start of the following day in the given time zone.
+func (system WeekSystem) ParseWeekDayEndExclusiveIn(input string, loc *time.Location) (time.Time, error) {
+	return exclusiveEnd(system.ParseWeekDayEndIn(input, loc))
+}
+
+// ParseWeekRange returns the range from midnight on the first day through 11:59:59pm on the last day
+// of the specified week string (e.g. "2019-W04"). The resulting range will be in UTC.
+func (system WeekSystem) ParseWeekRange(input string) (Range, error) {
+	return system.ParseWeekRangeIn(input, time.UTC)
+}
+
+// ParseWeekRangeIn returns the range from midnight on the first day through 11:59:59pm on the last day
+// of the specified week string (e.g. "2019-W04"). This will be in the local time of the specified location.
+func (system WeekSystem) ParseWeekRangeIn(input string, loc *time.Location) (Range, error) {
+	if loc == nil {
+		return Range{}, fmt.Errorf("parse week range: %w", ErrNilLocation)
+	}
+	weekYear, week, err := system.ParseWeek(input)
+	if err != nil {
+		return Range{}, err
+	}
+
+	year, month, day := system.StartDate(weekYear, week)
+	return Range{Start: Midnight(year, month, day, loc), End: AlmostMidnight(year, month, day+6, loc)}, nil
+}
+
+// ParseWeekDayRange accepts a year/week/day string (e.g. "2019-W04-3") and returns the range from midnight
+// through 11:59:59pm on the date that it represents in UTC.
+func (system WeekSystem) ParseWeekDayRange(input string) (Range, error) {
+	return system.ParseWeekDayRangeIn(input, time.UTC)
+}
+
+// ParseWeekDayRangeIn accepts a year/week/day string (e.g. "2019-W04-3") and returns the range from midnight
+// through 11:59:59pm on the date that it represents in the given time zone.
+func (system WeekSystem) ParseWeekDayRangeIn(input string, loc *time.Location) (Range, error) {
+	if loc == nil {
+		return Range{}, fmt.Errorf("parse week day range: %w", ErrNilLocation)
+	}
+	weekYear, week, day, err := system.ParseWeekDay(input)
+	if err != nil {
+		return Range{}, err
+	}
+	year, month, startDay := system.StartDate(weekYear, week)
+	return Range{Start: Midnight(year, month, startDay+day-1, loc), End: AlmostMidnight(year, month, startDay+day-1, loc)}, nil
+}
+
+// gregorianShift returns the number of years to add to the given year so that the Julian day number
+// math (which only works for years after 4800 BC) can handle it. The Gregorian calendar repeats every
+// 400 years, weekdays included, so shifting by whole cycles doesn't change how the weeks fall.
+func gregorianShift(year int) int {
+	if year > -4000 {
+		return 0
+	}
+	return ((-4000-year)/400 + 1) * 400
+}
+
+// weekOneStart returns the Julian day number of the first day of week 1 in the given year.
+func (system WeekSystem) weekOneStart(year int) int {
+	jan1 := isoweek.DateToJulian(year, time.January, 1)
+
+	// Back up to the first day of the week containing January 1st. If that week doesn't have enough
+	// days in the new year, it belongs to the previous year and week 1 is the following week.
+	jan1Weekday := time.Weekday(isoweek.ISOWeekday(year, time.January, 1) % 7)
+	daysBack := int(jan1Weekday-system.FirstWeekday+7) % 7
+	if 7-daysBack < system.MinDaysInFirstWeek {
+		return jan1 - daysBack + 7
+	}
+	return jan1 - daysBack
+}
+
+// validateWeek makes sure that the week number actually exists in the given week-numbering year.
+func (system WeekSystem) validateWeek(format string, input string, year int, week int) error {
+	weeksInYear, err := system.WeeksInYear(year)
+	if err != nil {
+		return err
+	}
+	if week > weeksInYear {
+		return nonexistentError(format, input, ComponentWeek, weekStart(input), weekStart(input)+2, fmt.Sprintf("%d has %d weeks", year, weeksInYear))
+	}
+	return nil
+}
+
+func (system WeekSystem) validate() error {
+	switch {
+	case system.FirstWeekday < time.Sunday || system.FirstWeekday > time.Saturday:
+		return fmt.Errorf("invalid first weekday: %d", system.FirstWeekday)
+	case system.MinDaysInFirstWeek < 1 || system.MinDaysInFirstWeek > 7:
+		return fmt.Errorf("invalid minimum days in first week: %d", system.MinDaysInFirstWeek)
+	}
+	return nil
+}
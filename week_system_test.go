@@ -0,0 +1,357 @@
+package isodates_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestWeekSystemSuite(t *testing.T) {
+	suite.Run(t, new(WeekSystemSuite))
+}
+
+type WeekSystemSuite struct {
+	ChronoSuite
+}
+
+func (suite *WeekSystemSuite) TestStartDate() {
+	check := func(system isodates.WeekSystem, weekYear, week int, year int, month time.Month, day int) {
+		actualYear, actualMonth, actualDay := system.StartDate(weekYear, week)
+		suite.Equal(year, actualYear, "incorrect year")
+		suite.Equal(month, actualMonth, "incorrect month")
+		suite.Equal(day, actualDay, "incorrect day")
+	}
+
+	check(isodates.ISOWeeks, 2019, 1, 2018, time.December, 31)
+	check(isodates.ISOWeeks, 2021, 1, 2021, time.January, 4)
+	check(isodates.ISOWeeks, 2004, 53, 2004, time.December, 27)
+
+	check(isodates.USWeeks, 2019, 1, 2018, time.December, 30)
+	check(isodates.USWeeks, 2021, 1, 2020, time.December, 27)
+	check(isodates.USWeeks, 2022, 1, 2021, time.December, 26)
+	check(isodates.USWeeks, 2023, 1, 2023, time.January, 1)
+	check(isodates.USWeeks, 2019, 5, 2019, time.January, 27)
+
+	check(isodates.MMWRWeeks, 2019, 1, 2018, time.December, 30)
+	check(isodates.MMWRWeeks, 2021, 1, 2021, time.January, 3)
+	check(isodates.MMWRWeeks, 2022, 1, 2022, time.January, 2)
+	check(isodates.MMWRWeeks, 2020, 53, 2020, time.December, 27)
+
+	check(isodates.MiddleEastWeeks, 2019, 1, 2018, time.December, 29)
+	check(isodates.MiddleEastWeeks, 2022, 1, 2022, time.January, 1)
+}
+
+func (suite *WeekSystemSuite) TestFromDate() {
+	check := func(system isodates.WeekSystem, year int, month time.Month, day int, weekYear, week int) {
+		actualWeekYear, actualWeek := system.FromDate(year, month, day)
+		suite.Equal(weekYear, actualWeekYear, "incorrect week year")
+		suite.Equal(week, actualWeek, "incorrect week")
+	}
+
+	// Should line up with the standard library's ISO week calculation.
+	for _, date := range []time.Time{
+		time.Date(2018, time.December, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2018, time.December, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, time.January, 3, 0, 0, 0, 0, time.UTC),
+		time.Date(2020, time.December, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2019, time.June, 15, 0, 0, 0, 0, time.UTC),
+	} {
+		weekYear, week := date.ISOWeek()
+		check(isodates.ISOWeeks, date.Year(), date.Month(), date.Day(), weekYear, week)
+	}
+
+	check(isodates.USWeeks, 2018, time.December, 30, 2019, 1)
+	check(isodates.USWeeks, 2018, time.December, 29, 2018, 52)
+	check(isodates.USWeeks, 2022, time.January, 1, 2022, 1)
+	check(isodates.USWeeks, 2022, time.January, 2, 2022, 2)
+
+	check(isodates.MMWRWeeks, 2021, time.January, 2, 2020, 53)
+	check(isodates.MMWRWeeks, 2021, time.January, 3, 2021, 1)
+	check(isodates.MMWRWeeks, 2022, time.January, 1, 2021, 52)
+
+	check(isodates.MiddleEastWeeks, 2018, time.December, 29, 2019, 1)
+	check(isodates.MiddleEastWeeks, 2019, time.January, 4, 2019, 1)
+	check(isodates.MiddleEastWeeks, 2019, time.January, 5, 2019, 2)
+}
+
+func (suite *WeekSystemSuite) TestWeeksInYear() {
+	succeeds := func(system isodates.WeekSystem, year int, expected int) {
+		weeks, err := system.WeeksInYear(year)
+		_ = suite.NoError(err) &&
+			suite.Equal(expected, weeks)
+	}
+	fails := func(system isodates.WeekSystem) {
+		_, err := system.WeeksInYear(2019)
+		suite.Error(err)
+	}
+	fails(isodates.WeekSystem{})
+	fails(isodates.WeekSystem{FirstWeekday: -1, MinDaysInFirstWeek: 4})
+
+	succeeds(isodates.ISOWeeks, 2019, 52)
+	succeeds(isodates.ISOWeeks, 2020, 53)
+	succeeds(isodates.MMWRWeeks, 2019, 52)
+	succeeds(isodates.MMWRWeeks, 2020, 53)
+	succeeds(isodates.USWeeks, 2022, 53)
+	succeeds(isodates.USWeeks, 2021, 52)
+}
+
+func (suite *WeekSystemSuite) TestParseWeek() {
+	succeeds := func(system isodates.WeekSystem, input string, expectedYear int, expectedWeek int) {
+		year, week, err := system.ParseWeek(input)
+		_ = suite.NoError(err) &&
+			suite.Equal(expectedYear, year) &&
+			suite.Equal(expectedWeek, week)
+	}
+	fails := func(system isodates.WeekSystem, input string) {
+		_, _, err := system.ParseWeek(input)
+		suite.Error(err)
+	}
+	fails(isodates.ISOWeeks, "")
+	fails(isodates.ISOWeeks, "2019-W53")
+	fails(isodates.MMWRWeeks, "2021-W53")
+	fails(isodates.USWeeks, "2021-W53")
+	fails(isodates.WeekSystem{}, "2019-W01")
+
+	succeeds(isodates.ISOWeeks, "2020-W53", 2020, 53)
+	succeeds(isodates.MMWRWeeks, "2020-W53", 2020, 53)
+	succeeds(isodates.USWeeks, "2022-W53", 2022, 53)
+	succeeds(isodates.USWeeks, "2019-W05", 2019, 5)
+}
+
+func (suite *WeekSystemSuite) TestParseWeekDay() {
+	succeeds := func(system isodates.WeekSystem, input string, expectedYear, expectedWeek, expectedDay int) {
+		year, week, day, err := system.ParseWeekDay(input)
+		_ = suite.NoError(err) &&
+			suite.Equal(expectedYear, year) &&
+			suite.Equal(expectedWeek, week) &&
+			suite.Equal(expectedDay, day)
+	}
+	fails := func(system isodates.WeekSystem, input string) {
+		_, _, _, err := system.ParseWeekDay(input)
+		suite.Error(err)
+	}
+	fails(isodates.ISOWeeks, "")
+	fails(isodates.ISOWeeks, "2019-W53-1")
+	fails(isodates.USWeeks, "2021-W53-1")
+	fails(isodates.WeekSystem{}, "2019-W01-1")
+
+	succeeds(isodates.USWeeks, "2022-W53-7", 2022, 53, 7)
+	succeeds(isodates.MMWRWeeks, "2019-W05-1", 2019, 5, 1)
+}
+
+func (suite *WeekSystemSuite) TestParseWeekStart() {
+	succeeds := func(system isodates.WeekSystem, input string, year int, month time.Month, day int) {
+		date, err := system.ParseWeekStart(input)
+		suite.AssertMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(system isodates.WeekSystem, input string) {
+		_, err := system.ParseWeekStart(input)
+		suite.Error(err)
+	}
+
+	// TestParseWeek should handle all of the variants of bad input, so make sure we propagate some.
+	fails(isodates.USWeeks, "")
+	fails(isodates.USWeeks, "2019-WJ4")
+	fails(isodates.WeekSystem{}, "2019-W04")
+	fails(isodates.WeekSystem{FirstWeekday: 7, MinDaysInFirstWeek: 1}, "2019-W04")
+	fails(isodates.WeekSystem{MinDaysInFirstWeek: 8}, "2019-W04")
+
+	succeeds(isodates.ISOWeeks, "2019-W01", 2018, time.December, 31)
+	succeeds(isodates.USWeeks, "2019-W01", 2018, time.December, 30)
+	succeeds(isodates.USWeeks, "2019-W05", 2019, time.January, 27)
+	succeeds(isodates.MMWRWeeks, "2021-W01", 2021, time.January, 3)
+	succeeds(isodates.MiddleEastWeeks, "2019-W01", 2018, time.December, 29)
+}
+
+func (suite *WeekSystemSuite) TestParseWeekStartIn() {
+	succeeds := func(system isodates.WeekSystem, input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := system.ParseWeekStartIn(input, loc)
+		suite.AssertMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(system isodates.WeekSystem, input string, loc *time.Location) {
+		_, err := system.ParseWeekStartIn(input, loc)
+		suite.Error(err)
+	}
+	fails(isodates.USWeeks, "", locationEDT)
+	fails(isodates.USWeeks, "2019-W04", nil)
+
+	succeeds(isodates.USWeeks, "2019-W05", locationEDT, 2019, time.January, 27)
+	succeeds(isodates.USWeeks, "2019-W05", locationPDT, 2019, time.January, 27)
+	succeeds(isodates.MMWRWeeks, "2021-W01", locationEDT, 2021, time.January, 3)
+}
+
+func (suite *WeekSystemSuite) TestParseWeekEnd() {
+	succeeds := func(system isodates.WeekSystem, input string, year int, month time.Month, day int) {
+		date, err := system.ParseWeekEnd(input)
+		suite.AssertAlmostMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(system isodates.WeekSystem, input string) {
+		_, err := system.ParseWeekEnd(input)
+		suite.Error(err)
+	}
+	fails(isodates.USWeeks, "")
+	fails(isodates.USWeeks, "2019-WJ4")
+	fails(isodates.WeekSystem{}, "2019-W04")
+
+	succeeds(isodates.ISOWeeks, "2019-W01", 2019, time.January, 6)
+	succeeds(isodates.USWeeks, "2019-W01", 2019, time.January, 5)
+	succeeds(isodates.MMWRWeeks, "2020-W53", 2021, time.January, 2)
+	succeeds(isodates.MiddleEastWeeks, "2019-W01", 2019, time.January, 4)
+}
+
+func (suite *WeekSystemSuite) TestParseWeekEndIn() {
+	succeeds := func(system isodates.WeekSystem, input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := system.ParseWeekEndIn(input, loc)
+		suite.AssertAlmostMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(system isodates.WeekSystem, input string, loc *time.Location) {
+		_, err := system.ParseWeekEndIn(input, loc)
+		suite.Error(err)
+	}
+	fails(isodates.USWeeks, "", locationEDT)
+	fails(isodates.USWeeks, "2019-W04", nil)
+
+	succeeds(isodates.USWeeks, "2019-W01", locationEDT, 2019, time.January, 5)
+	succeeds(isodates.USWeeks, "2019-W01", locationPDT, 2019, time.January, 5)
+}
+
+func (suite *WeekSystemSuite) TestParseWeekDayStart() {
+	succeeds := func(system isodates.WeekSystem, input string, year int, month time.Month, day int) {
+		date, err := system.ParseWeekDayStart(input)
+		suite.AssertMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(system isodates.WeekSystem, input string) {
+		_, err := system.ParseWeekDayStart(input)
+		suite.Error(err)
+	}
+
+	// TestParseWeekDay should handle all of the variants of bad input, so make sure we propagate some.
+	fails(isodates.USWeeks, "")
+	fails(isodates.USWeeks, "2019-W04-8")
+	fails(isodates.WeekSystem{}, "2019-W04-1")
+
+	// Day 1 is always the system's first weekday.
+	succeeds(isodates.ISOWeeks, "2019-W01-1", 2018, time.December, 31)
+	succeeds(isodates.USWeeks, "2019-W01-1", 2018, time.December, 30)
+	succeeds(isodates.USWeeks, "2019-W01-7", 2019, time.January, 5)
+	succeeds(isodates.MiddleEastWeeks, "2019-W01-2", 2018, time.December, 30)
+}
+
+func (suite *WeekSystemSuite) TestParseWeekDayStartIn() {
+	succeeds := func(system isodates.WeekSystem, input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := system.ParseWeekDayStartIn(input, loc)
+		suite.AssertMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(system isodates.WeekSystem, input string, loc *time.Location) {
+		_, err := system.ParseWeekDayStartIn(input, loc)
+		suite.Error(err)
+	}
+	fails(isodates.USWeeks, "", locationEDT)
+	fails(isodates.USWeeks, "2019-W04-1", nil)
+
+	succeeds(isodates.USWeeks, "2019-W01-1", locationEDT, 2018, time.December, 30)
+	succeeds(isodates.MMWRWeeks, "2021-W01-3", locationPDT, 2021, time.January, 5)
+}
+
+func (suite *WeekSystemSuite) TestParseWeekDayEnd() {
+	succeeds := func(system isodates.WeekSystem, input string, year int, month time.Month, day int) {
+		date, err := system.ParseWeekDayEnd(input)
+		suite.AssertAlmostMidnightUTC(date, err, year, month, day)
+	}
+	fails := func(system isodates.WeekSystem, input string) {
+		_, err := system.ParseWeekDayEnd(input)
+		suite.Error(err)
+	}
+	fails(isodates.USWeeks, "")
+	fails(isodates.USWeeks, "2019-W04-8")
+	fails(isodates.WeekSystem{}, "2019-W04-1")
+
+	succeeds(isodates.USWeeks, "2019-W01-1", 2018, time.December, 30)
+	succeeds(isodates.MMWRWeeks, "2021-W01-7", 2021, time.January, 9)
+}
+
+func (suite *WeekSystemSuite) TestParseWeekDayEndIn() {
+	succeeds := func(system isodates.WeekSystem, input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := system.ParseWeekDayEndIn(input, loc)
+		suite.AssertAlmostMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(system isodates.WeekSystem, input string, loc *time.Location) {
+		_, err := system.ParseWeekDayEndIn(input, loc)
+		suite.Error(err)
+	}
+	fails(isodates.USWeeks, "", locationEDT)
+	fails(isodates.USWeeks, "2019-W04-1", nil)
+
+	succeeds(isodates.USWeeks, "2019-W01-1", locationEDT, 2018, time.December, 30)
+	succeeds(isodates.MMWRWeeks, "2021-W01-7", locationPDT, 2021, time.January, 9)
+}
+
+func (suite *WeekSystemSuite) TestParseWeekEndExclusiveIn() {
+	_, err := isodates.USWeeks.ParseWeekEndExclusiveIn("2019-W05", nil)
+	suite.Error(err)
+	_, err = isodates.USWeeks.ParseWeekDayEndExclusiveIn("2019-W05-8", time.UTC)
+	suite.Error(err)
+
+	date, err := isodates.USWeeks.ParseWeekEndExclusiveIn("2019-W05", locationEDT)
+	suite.AssertMidnightIn(date, err, 2019, time.February, 3, locationEDT)
+
+	date, err = isodates.USWeeks.ParseWeekEndExclusive("2019-W05")
+	suite.AssertMidnightUTC(date, err, 2019, time.February, 3)
+
+	date, err = isodates.USWeeks.ParseWeekDayEndExclusiveIn("2019-W05-7", locationPDT)
+	suite.AssertMidnightIn(date, err, 2019, time.February, 3, locationPDT)
+
+	date, err = isodates.USWeeks.ParseWeekDayEndExclusive("2019-W05-1")
+	suite.AssertMidnightUTC(date, err, 2019, time.January, 28)
+}
+
+func (suite *WeekSystemSuite) TestParseWeekRangeIn() {
+	succeeds := func(system isodates.WeekSystem, input string, loc *time.Location, startYear int, startMonth time.Month, startDay int, endYear int, endMonth time.Month, endDay int) {
+		r, err := system.ParseWeekRangeIn(input, loc)
+		suite.AssertRangeIn(r, err, startYear, startMonth, startDay, endYear, endMonth, endDay, loc)
+	}
+	fails := func(system isodates.WeekSystem, input string, loc *time.Location) {
+		_, err := system.ParseWeekRangeIn(input, loc)
+		suite.Error(err)
+	}
+	fails(isodates.USWeeks, "", time.UTC)
+	fails(isodates.USWeeks, "2019-W05", nil)
+
+	succeeds(isodates.USWeeks, "2019-W05", locationEDT, 2019, time.January, 27, 2019, time.February, 2)
+	succeeds(isodates.MiddleEastWeeks, "2019-W01", time.UTC, 2018, time.December, 29, 2019, time.January, 4)
+
+	r, err := isodates.USWeeks.ParseWeekRange("2019-W05")
+	suite.AssertRangeIn(r, err, 2019, time.January, 27, 2019, time.February, 2, time.UTC)
+}
+
+func (suite *WeekSystemSuite) TestParseWeekDayRangeIn() {
+	succeeds := func(system isodates.WeekSystem, input string, loc *time.Location, year int, month time.Month, day int) {
+		r, err := system.ParseWeekDayRangeIn(input, loc)
+		suite.AssertRangeIn(r, err, year, month, day, year, month, day, loc)
+	}
+	fails := func(system isodates.WeekSystem, input string, loc *time.Location) {
+		_, err := system.ParseWeekDayRangeIn(input, loc)
+		suite.Error(err)
+	}
+	fails(isodates.USWeeks, "", time.UTC)
+	fails(isodates.USWeeks, "2019-W05-1", nil)
+
+	succeeds(isodates.USWeeks, "2019-W05-1", locationEDT, 2019, time.January, 27)
+	succeeds(isodates.USWeeks, "2019-W05-7", time.UTC, 2019, time.February, 2)
+
+	r, err := isodates.USWeeks.ParseWeekDayRange("2019-W05-2")
+	suite.AssertRangeIn(r, err, 2019, time.January, 28, 2019, time.January, 28, time.UTC)
+}
+
+func ExampleWeekSystem_ParseWeekStart() {
+	iso, _ := isodates.ISOWeeks.ParseWeekStart("2019-W05")
+	us, _ := isodates.USWeeks.ParseWeekStart("2019-W05")
+	fmt.Println(iso.Format("Mon Jan 2, 2006"))
+	fmt.Println(us.Format("Mon Jan 2, 2006"))
+
+	// Output: Mon Jan 28, 2019
+	// Sun Jan 27, 2019
+}
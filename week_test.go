@@ -54,6 +54,14 @@ func (suite *WeekSuite) TestParseWeek() {
 	fails("3-W04")
 	fails("1234-W4")
 
+	// Basic format and expanded years
+	fails("2019W5")
+	fails("2019W053")
+	fails("2019W53") // 2019 only has 52 ISO weeks
+	fails("+201-W05")
+	fails("+-019-W05")
+	fails("+2019/W05")
+
 	succeeds("2000-W01", 2000, 1)
 	succeeds("2000-W11", 2000, 11)
 	succeeds("2019-W11", 2019, 11)
@@ -62,6 +70,31 @@ func (suite *WeekSuite) TestParseWeek() {
 	succeeds("0012-W12", 12, 12)
 	succeeds("0001-W12", 1, 12)
 	succeeds("0001-W01", 1, 1)
+	succeeds("2004-W53", 2004, 53)
+	succeeds("2015-W53", 2015, 53)
+	succeeds("2020-W53", 2020, 53)
+	succeeds("2019W05", 2019, 5)
+	succeeds("2020W53", 2020, 53)
+	succeeds("+002019-W05", 2019, 5)
+	succeeds("+002019W05", 2019, 5)
+	succeeds("+10000-W01", 10000, 1)
+	succeeds("-0044-W11", -44, 11)
+}
+
+func (suite *WeekSuite) TestParseWeekError() {
+	_, _, err := isodates.ParseWeek("2019-W53")
+	_ = suite.Error(err) &&
+		suite.Contains(err.Error(), "2019 has 52 weeks")
+}
+
+func (suite *WeekSuite) TestWeeksInYear() {
+	suite.Equal(52, isodates.WeeksInYear(1999))
+	suite.Equal(53, isodates.WeeksInYear(2004))
+	suite.Equal(52, isodates.WeeksInYear(2014))
+	suite.Equal(53, isodates.WeeksInYear(2015))
+	suite.Equal(52, isodates.WeeksInYear(2019))
+	suite.Equal(53, isodates.WeeksInYear(2020))
+	suite.Equal(53, isodates.WeeksInYear(2026))
 }
 
 func (suite *WeekSuite) TestParseWeekStart() {
@@ -79,13 +112,13 @@ func (suite *WeekSuite) TestParseWeekStart() {
 	fails("not valid")
 	fails("W01-2019")
 	fails("2019-WJ4")
+	fails("1999-W53") // 1999 only has 52 ISO weeks, so don't roll into 2000-W01
 
 	succeeds("2019-W01", 2018, time.December, 31)
 	succeeds("2019-W02", 2019, time.January, 7)
 	succeeds("2000-W01", 2000, time.January, 3)
 	succeeds("1999-W52", 1999, time.December, 27)
 	succeeds("2000-W09", 2000, time.February, 28)
-	succeeds("1999-W53", 2000, time.January, 3)   // 53rd week rolls to next year
 	succeeds("2004-W53", 2004, time.December, 27) // long year where still in that year
 }
 
@@ -105,13 +138,13 @@ func (suite *WeekSuite) TestParseWeekStartIn() {
 	fails("W01-2019", locationEDT)
 	fails("2019-WJ4", locationEDT)
 	fails("2019-W04", nil)
+	fails("1999-W53", locationEDT)
 
 	succeeds("2019-W01", locationEDT, 2018, time.December, 31)
 	succeeds("2019-W02", locationEDT, 2019, time.January, 7)
 	succeeds("2000-W01", locationEDT, 2000, time.January, 3)
 	succeeds("1999-W52", locationEDT, 1999, time.December, 27)
 	succeeds("2000-W09", locationEDT, 2000, time.February, 28)
-	succeeds("1999-W53", locationEDT, 2000, time.January, 3)   // 53rd week rolls to next year
 	succeeds("2004-W53", locationEDT, 2004, time.December, 27) // long year where still in that year
 
 	// Make sure everything still works when given a different time zone.
@@ -120,7 +153,6 @@ func (suite *WeekSuite) TestParseWeekStartIn() {
 	succeeds("2000-W01", locationPDT, 2000, time.January, 3)
 	succeeds("1999-W52", locationPDT, 1999, time.December, 27)
 	succeeds("2000-W09", locationPDT, 2000, time.February, 28)
-	succeeds("1999-W53", locationPDT, 2000, time.January, 3)
 	succeeds("2004-W53", locationPDT, 2004, time.December, 27)
 }
 
@@ -139,13 +171,13 @@ func (suite *WeekSuite) TestParseWeekEnd() {
 	fails("not valid")
 	fails("W01-2019")
 	fails("2019-WJ4")
+	fails("1999-W53") // 1999 only has 52 ISO weeks, so don't roll into 2000-W01
 
 	succeeds("2019-W01", 2019, time.January, 6)
 	succeeds("2019-W02", 2019, time.January, 13)
 	succeeds("2000-W01", 2000, time.January, 9)
 	succeeds("1999-W52", 2000, time.January, 2)
 	succeeds("2000-W09", 2000, time.March, 5)
-	succeeds("1999-W53", 2000, time.January, 9) // 53rd week rolls to next year
 	succeeds("2004-W53", 2005, time.January, 2) // long year where still in that year
 }
 
@@ -165,13 +197,13 @@ func (suite *WeekSuite) TestParseWeekEndIn() {
 	fails("W01-2019", locationEDT)
 	fails("2019-WJ4", locationEDT)
 	fails("2019-W04", nil)
+	fails("1999-W53", locationEDT)
 
 	succeeds("2019-W01", locationEDT, 2019, time.January, 6)
 	succeeds("2019-W02", locationEDT, 2019, time.January, 13)
 	succeeds("2000-W01", locationEDT, 2000, time.January, 9)
 	succeeds("1999-W52", locationEDT, 2000, time.January, 2)
 	succeeds("2000-W09", locationEDT, 2000, time.March, 5)
-	succeeds("1999-W53", locationEDT, 2000, time.January, 9) // 53rd week rolls to next year
 	succeeds("2004-W53", locationEDT, 2005, time.January, 2) // long year where still in that year
 
 	// Make sure everything still works when given a different time zone.
@@ -180,10 +212,51 @@ func (suite *WeekSuite) TestParseWeekEndIn() {
 	succeeds("2000-W01", locationPDT, 2000, time.January, 9)
 	succeeds("1999-W52", locationPDT, 2000, time.January, 2)
 	succeeds("2000-W09", locationPDT, 2000, time.March, 5)
-	succeeds("1999-W53", locationPDT, 2000, time.January, 9) // 53rd week rolls to next year
 	succeeds("2004-W53", locationPDT, 2005, time.January, 2) // long year where still in that year
 }
 
+func (suite *WeekSuite) TestParseWeekEndExclusiveIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := isodates.ParseWeekEndExclusiveIn(input, loc)
+		suite.AssertMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.ParseWeekEndExclusiveIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", time.UTC)
+	fails("2019-W53", time.UTC)
+	fails("2019-W05", nil)
+
+	succeeds("2019-W05", time.UTC, 2019, time.February, 4)
+	succeeds("2019-W52", locationEDT, 2019, time.December, 30)
+	succeeds("2020-W53", locationPDT, 2021, time.January, 4)
+
+	date, err := isodates.ParseWeekEndExclusive("2019-W05")
+	suite.AssertMidnightUTC(date, err, 2019, time.February, 4)
+}
+
+func (suite *WeekSuite) TestParseWeekRangeIn() {
+	succeeds := func(input string, loc *time.Location, startYear int, startMonth time.Month, startDay int, endYear int, endMonth time.Month, endDay int) {
+		r, err := isodates.ParseWeekRangeIn(input, loc)
+		suite.AssertRangeIn(r, err, startYear, startMonth, startDay, endYear, endMonth, endDay, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.ParseWeekRangeIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", time.UTC)
+	fails("2019-W53", time.UTC)
+	fails("2019-W05", nil)
+
+	succeeds("2019-W05", time.UTC, 2019, time.January, 28, 2019, time.February, 3)
+	succeeds("2019-W01", locationEDT, 2018, time.December, 31, 2019, time.January, 6)
+	succeeds("2020-W53", locationPDT, 2020, time.December, 28, 2021, time.January, 3)
+
+	r, err := isodates.ParseWeekRange("2019-W05")
+	suite.AssertRangeIn(r, err, 2019, time.January, 28, 2019, time.February, 3, time.UTC)
+}
+
 func ExampleParseWeek() {
 	year, weekNumber, err := isodates.ParseWeek("2019-W02")
 	fmt.Println(fmt.Sprintf("%d %d %v", year, weekNumber, err == nil))
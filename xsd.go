@@ -0,0 +1,389 @@
+package isodates
+
+import (
+	"fmt"
+	"time"
+)
+
+/*
+ * XML Schema (XSD) has five "g" types for partial Gregorian dates: gYear ("2019"), gYearMonth
+ * ("2019-05"), gMonthDay ("--12-25"), gDay ("---15"), and gMonth ("--12"). Unlike their ISO 8601
+ * counterparts, each one can have a time zone suffix: either "Z" or an offset such as "+02:00". The
+ * parsers keep that time zone as a fixed *time.Location (nil when there isn't one), and the Start/End
+ * helpers use it instead of the location you pass in when it's present.
+ */
+
+const (
+	xsdYearFormat      = "YYYY[Z|±hh:mm]"
+	xsdYearMonthFormat = "YYYY-MM[Z|±hh:mm]"
+	xsdMonthDayFormat  = "--MM-DD[Z|±hh:mm]"
+	xsdDayFormat       = "---DD[Z|±hh:mm]"
+	xsdMonthFormat     = "--MM[Z|±hh:mm]"
+)
+
+// GYear is an XML Schema gYear such as "2019" or "2019Z".
+type GYear struct {
+	Year int
+	// Location is the fixed time zone from the input's suffix, or nil if it didn't have one.
+	Location *time.Location
+}
+
+// ParseGYear accepts an XML Schema gYear such as "2019", "-0044", or "2019+02:00". Years have at
+// least 4 digits, and longer ones can't start with a zero.
+func ParseGYear(input string) (GYear, error) {
+	end, loc, err := parseXSDTimeZone(xsdYearFormat, input)
+	if err != nil {
+		return GYear{}, err
+	}
+	year, err := parseXSDYear(xsdYearFormat, input, end)
+	if err != nil {
+		return GYear{}, err
+	}
+	return GYear{Year: year, Location: loc}, nil
+}
+
+// String returns the gYear in its canonical form such as "2019" or "2019+02:00".
+func (g GYear) String() string {
+	return xsdYear(g.Year) + xsdTimeZone(g.Location)
+}
+
+// Start returns midnight on January 1st of the year. It's in the gYear's own time zone if it has one,
+// otherwise it's in the given location.
+func (g GYear) Start(loc *time.Location) time.Time {
+	return Midnight(g.Year, time.January, 1, xsdLocation(g.Location, loc))
+}
+
+// End returns 11:59:59pm on December 31st of the year. It's in the gYear's own time zone if it has one,
+// otherwise it's in the given location.
+func (g GYear) End(loc *time.Location) time.Time {
+	return AlmostMidnight(g.Year, time.December, 31, xsdLocation(g.Location, loc))
+}
+
+// Range returns the range from Start() through End().
+func (g GYear) Range(loc *time.Location) Range {
+	return Range{Start: g.Start(loc), End: g.End(loc)}
+}
+
+// GYearMonth is an XML Schema gYearMonth such as "2019-05" or "2019-05+02:00".
+type GYearMonth struct {
+	Year  int
+	Month time.Month
+	// Location is the fixed time zone from the input's suffix, or nil if it didn't have one.
+	Location *time.Location
+}
+
+// ParseGYearMonth accepts an XML Schema gYearMonth such as "2019-05", "2019-05Z", or "2019-05-04:00".
+func ParseGYearMonth(input string) (GYearMonth, error) {
+	end, loc, err := parseXSDTimeZone(xsdYearMonthFormat, input)
+	if err != nil {
+		return GYearMonth{}, err
+	}
+	if end < 7 {
+		return GYearMonth{}, formatError(xsdYearMonthFormat, input, end)
+	}
+	if err = checkLiteral(xsdYearMonthFormat, input, end-3, "-"); err != nil {
+		return GYearMonth{}, err
+	}
+	year, err := parseXSDYear(xsdYearMonthFormat, input, end-3)
+	if err != nil {
+		return GYearMonth{}, err
+	}
+	month, err := parseMonth(xsdYearMonthFormat, input, end-2, end)
+	if err != nil {
+		return GYearMonth{}, err
+	}
+	return GYearMonth{Year: year, Month: month, Location: loc}, nil
+}
+
+// String returns the gYearMonth in its canonical form such as "2019-05" or "2019-05+02:00".
+func (g GYearMonth) String() string {
+	return fmt.Sprintf("%s-%02d%s", xsdYear(g.Year), int(g.Month), xsdTimeZone(g.Location))
+}
+
+// Start returns midnight on the first day of the month. It's in the gYearMonth's own time zone if it
+// has one, otherwise it's in the given location.
+func (g GYearMonth) Start(loc *time.Location) time.Time {
+	return YearMonth{Year: g.Year, Month: g.Month}.Start(xsdLocation(g.Location, loc))
+}
+
+// End returns 11:59:59pm on the last day of the month. It's in the gYearMonth's own time zone if it
+// has one, otherwise it's in the given location.
+func (g GYearMonth) End(loc *time.Location) time.Time {
+	return YearMonth{Year: g.Year, Month: g.Month}.End(xsdLocation(g.Location, loc))
+}
+
+// Range returns the range from Start() through End().
+func (g GYearMonth) Range(loc *time.Location) Range {
+	return Range{Start: g.Start(loc), End: g.End(loc)}
+}
+
+// GMonthDay is an XML Schema gMonthDay such as "--12-25" or "--12-25Z".
+type GMonthDay struct {
+	Month time.Month
+	Day   int
+	// Location is the fixed time zone from the input's suffix, or nil if it didn't have one.
+	Location *time.Location
+}
+
+// ParseGMonthDay accepts an XML Schema gMonthDay such as "--12-25" or "--12-25Z". Just like ParseMonthDay,
+// the day must exist in that month in at least some year, so "--02-29" is valid but "--04-31" is not.
+// Unlike ParseMonthDay, the month and day must both be 2 digits.
+func ParseGMonthDay(input string) (GMonthDay, error) {
+	end, loc, err := parseXSDTimeZone(xsdMonthDayFormat, input)
+	if err != nil {
+		return GMonthDay{}, err
+	}
+	if err = checkXSDLength(xsdMonthDayFormat, input, end, 7); err != nil {
+		return GMonthDay{}, err
+	}
+	if err = checkLiteral(xsdMonthDayFormat, input, 0, "--"); err != nil {
+		return GMonthDay{}, err
+	}
+	if err = checkLiteral(xsdMonthDayFormat, input, 4, "-"); err != nil {
+		return GMonthDay{}, err
+	}
+	month, err := parseMonth(xsdMonthDayFormat, input, 2, 4)
+	if err != nil {
+		return GMonthDay{}, err
+	}
+	day, err := parseNumber(xsdMonthDayFormat, input, 5, 7, ComponentDay, 1, 31)
+	if err != nil {
+		return GMonthDay{}, err
+	}
+	// Year 2000 was a leap year, so this gives us the most days that the month can ever have.
+	if daysInMonth := DaysInMonth(2000, month); day > daysInMonth {
+		detail := fmt.Sprintf("%s has at most %d days", month, daysInMonth)
+		return GMonthDay{}, nonexistentError(xsdMonthDayFormat, input, ComponentDay, 5, 7, detail)
+	}
+	return GMonthDay{Month: month, Day: day, Location: loc}, nil
+}
+
+// String returns the gMonthDay in its canonical form such as "--12-25" or "--12-25Z".
+func (g GMonthDay) String() string {
+	return fmt.Sprintf("--%02d-%02d%s", int(g.Month), g.Day, xsdTimeZone(g.Location))
+}
+
+// Start returns midnight on this month/day in the given year. It's in the gMonthDay's own time zone if
+// it has one, otherwise it's in the given location. Feb 29th moves to March 1st in non-leap years.
+func (g GMonthDay) Start(year int, loc *time.Location) time.Time {
+	return MonthDay{Month: g.Month, Day: g.Day}.Start(year, xsdLocation(g.Location, loc))
+}
+
+// End returns 11:59:59pm on this month/day in the given year. It's in the gMonthDay's own time zone if
+// it has one, otherwise it's in the given location. Feb 29th moves to March 1st in non-leap years.
+func (g GMonthDay) End(year int, loc *time.Location) time.Time {
+	return MonthDay{Month: g.Month, Day: g.Day}.End(year, xsdLocation(g.Location, loc))
+}
+
+// Range returns the range from Start() through End() in the given year.
+func (g GMonthDay) Range(year int, loc *time.Location) Range {
+	return Range{Start: g.Start(year, loc), End: g.End(year, loc)}
+}
+
+// GDay is an XML Schema gDay such as "---15" or "---15Z"; a day that recurs every month.
+type GDay struct {
+	Day int
+	// Location is the fixed time zone from the input's suffix, or nil if it didn't have one.
+	Location *time.Location
+}
+
+// ParseGDay accepts an XML Schema gDay such as "---15" or "---15-05:00". The day must be 2 digits
+// between 01 and 31.
+func ParseGDay(input string) (GDay, error) {
+	end, loc, err := parseXSDTimeZone(xsdDayFormat, input)
+	if err != nil {
+		return GDay{}, err
+	}
+	if err = checkXSDLength(xsdDayFormat, input, end, 5); err != nil {
+		return GDay{}, err
+	}
+	if err = checkLiteral(xsdDayFormat, input, 0, "---"); err != nil {
+		return GDay{}, err
+	}
+	day, err := parseNumber(xsdDayFormat, input, 3, 5, ComponentDay, 1, 31)
+	if err != nil {
+		return GDay{}, err
+	}
+	return GDay{Day: day, Location: loc}, nil
+}
+
+// String returns the gDay in its canonical form such as "---15" or "---15Z".
+func (g GDay) String() string {
+	return fmt.Sprintf("---%02d%s", g.Day, xsdTimeZone(g.Location))
+}
+
+// Start returns midnight on this day of the given year/month. It's in the gDay's own time zone if it has
+// one, otherwise it's in the given location. Days that don't exist in that month (e.g. the 31st of April)
+// roll over into the following month.
+func (g GDay) Start(year int, month time.Month, loc *time.Location) time.Time {
+	return Midnight(year, month, g.Day, xsdLocation(g.Location, loc))
+}
+
+// End returns 11:59:59pm on this day of the given year/month. It's in the gDay's own time zone if it has
+// one, otherwise it's in the given location. Days that don't exist in that month roll over into the
+// following month.
+func (g GDay) End(year int, month time.Month, loc *time.Location) time.Time {
+	return AlmostMidnight(year, month, g.Day, xsdLocation(g.Location, loc))
+}
+
+// Range returns the range from Start() through End() in the given year/month.
+func (g GDay) Range(year int, month time.Month, loc *time.Location) Range {
+	return Range{Start: g.Start(year, month, loc), End: g.End(year, month, loc)}
+}
+
+// GMonth is an XML Schema gMonth such as "--12" or "--12Z"; a month that recurs every year.
+type GMonth struct {
+	Month time.Month
+	// Location is the fixed time zone from the input's suffix, or nil if it didn't have one.
+	Location *time.Location
+}
+
+// ParseGMonth accepts an XML Schema gMonth such as "--12" or "--12+02:00". We also accept the "--12--"
+// form from the original XML Schema spec, which some older SOAP stacks still send.
+func ParseGMonth(input string) (GMonth, error) {
+	end, loc, err := parseXSDTimeZone(xsdMonthFormat, input)
+	if err != nil {
+		return GMonth{}, err
+	}
+	if end == 6 && input[4:6] == "--" {
+		end = 4
+	}
+	if err = checkXSDLength(xsdMonthFormat, input, end, 4); err != nil {
+		return GMonth{}, err
+	}
+	if err = checkLiteral(xsdMonthFormat, input, 0, "--"); err != nil {
+		return GMonth{}, err
+	}
+	month, err := parseMonth(xsdMonthFormat, input, 2, 4)
+	if err != nil {
+		return GMonth{}, err
+	}
+	return GMonth{Month: month, Location: loc}, nil
+}
+
+// String returns the gMonth in its canonical form such as "--12" or "--12Z".
+func (g GMonth) String() string {
+	return fmt.Sprintf("--%02d%s", int(g.Month), xsdTimeZone(g.Location))
+}
+
+// Start returns midnight on the first day of this month in the given year. It's in the gMonth's own time
+// zone if it has one, otherwise it's in the given location.
+func (g GMonth) Start(year int, loc *time.Location) time.Time {
+	return YearMonth{Year: year, Month: g.Month}.Start(xsdLocation(g.Location, loc))
+}
+
+// End returns 11:59:59pm on the last day of this month in the given year. It's in the gMonth's own time
+// zone if it has one, otherwise it's in the given location.
+func (g GMonth) End(year int, loc *time.Location) time.Time {
+	return YearMonth{Year: year, Month: g.Month}.End(xsdLocation(g.Location, loc))
+}
+
+// Range returns the range from Start() through End() in the given year.
+func (g GMonth) Range(year int, loc *time.Location) Range {
+	return Range{Start: g.Start(year, loc), End: g.End(year, loc)}
+}
+
+// parseXSDTimeZone looks for a time zone suffix ("Z", "+hh:mm", or "-hh:mm") on the input. It returns
+// where the value before the suffix ends and the fixed location for the suffix (nil if there isn't one).
+// Offsets of zero, including "-00:00", are UTC.
+func parseXSDTimeZone(format string, input string) (int, *time.Location, error) {
+	length := len(input)
+	switch {
+	case length > 0 && input[length-1] == 'Z':
+		return length - 1, time.UTC, nil
+	case length < 6 || input[length-3] != ':':
+		return length, nil, nil
+	}
+
+	zoneStart := length - 6
+	sign := 1
+	switch input[zoneStart] {
+	case '+':
+	case '-':
+		sign = -1
+	default:
+		return 0, nil, formatError(format, input, zoneStart)
+	}
+	hours, err := parseNumber(format, input, zoneStart+1, zoneStart+3, ComponentTimeZone, 0, 14)
+	if err != nil {
+		return 0, nil, err
+	}
+	minutes, err := parseNumber(format, input, zoneStart+4, length, ComponentTimeZone, 0, 59)
+	if err != nil {
+		return 0, nil, err
+	}
+	// The largest offset allowed is exactly 14 hours.
+	if hours == 14 && minutes > 0 {
+		return 0, nil, componentError(format, input, ComponentTimeZone, zoneStart+4, length, ReasonOutOfRange)
+	}
+
+	offset := sign * (hours*60 + minutes) * 60
+	if offset == 0 {
+		return zoneStart, time.UTC, nil
+	}
+	return zoneStart, time.FixedZone(input[zoneStart:], offset), nil
+}
+
+// parseXSDYear parses the year found at input[0:end]. XML Schema years have an optional '-' sign and
+// at least 4 digits; only years that need more than 4 digits can be longer, so there's no leading zero.
+func parseXSDYear(format string, input string, end int) (int, error) {
+	digitStart := 0
+	if end > 0 && input[0] == '-' {
+		digitStart = 1
+	}
+	switch {
+	case end-digitStart < 4:
+		return 0, formatError(format, input, end)
+	case end-digitStart > 4 && input[digitStart] == '0':
+		return 0, formatError(format, input, digitStart)
+	case digitStart == 0:
+		// XML Schema doesn't allow a '+' sign, so an unsigned year has to be all digits.
+		return parseYear(format, input, 0, end)
+	}
+	return parseSignedYear(format, input, 0, end)
+}
+
+// checkXSDLength makes sure that the value before the time zone suffix (input[0:end]) has the given length.
+func checkXSDLength(format string, input string, end int, length int) error {
+	switch {
+	case end < length:
+		return formatError(format, input, end)
+	case end > length:
+		return formatError(format, input, length)
+	}
+	return nil
+}
+
+// xsdLocation returns the value's own time zone if it has one, otherwise the fallback location.
+func xsdLocation(zone *time.Location, fallback *time.Location) *time.Location {
+	if zone != nil {
+		return zone
+	}
+	return fallback
+}
+
+// xsdYear formats the year with at least 4 digits and no '+' sign, since XML Schema doesn't allow one.
+func xsdYear(year int) string {
+	if year < 0 {
+		return fmt.Sprintf("-%04d", -year)
+	}
+	return fmt.Sprintf("%04d", year)
+}
+
+// xsdTimeZone formats the location as a time zone suffix: "" when there's no location, "Z" for UTC, and
+// otherwise an offset such as "+02:00". The parsers always give you fixed offsets; for other locations
+// we use their offset on January 1st, 2000.
+func xsdTimeZone(loc *time.Location) string {
+	if loc == nil {
+		return ""
+	}
+	_, offset := time.Date(2000, time.January, 1, 0, 0, 0, 0, loc).Zone()
+	if offset == 0 {
+		return "Z"
+	}
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	return fmt.Sprintf("%c%02d:%02d", sign, offset/3600, offset/60%60)
+}
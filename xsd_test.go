@@ -0,0 +1,263 @@
+package isodates_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/robsignorelli/isodates"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestXSDSuite(t *testing.T) {
+	suite.Run(t, new(XSDSuite))
+}
+
+type XSDSuite struct {
+	ChronoSuite
+}
+
+// offset returns the UTC offset of the location in seconds, or -1 if there is no location.
+func offset(loc *time.Location) int {
+	if loc == nil {
+		return -1
+	}
+	_, seconds := time.Date(2019, time.May, 22, 0, 0, 0, 0, loc).Zone()
+	return seconds
+}
+
+func (suite *XSDSuite) TestParseGYear() {
+	succeeds := func(input string, year int, zone int, canonical string) {
+		value, err := isodates.ParseGYear(input)
+		_ = suite.NoError(err, input) &&
+			suite.Equal(year, value.Year, input) &&
+			suite.Equal(zone, offset(value.Location), input) &&
+			suite.Equal(canonical, value.String(), input)
+	}
+	fails := func(input string) {
+		_, err := isodates.ParseGYear(input)
+		var parseErr *isodates.ParseError
+		suite.True(errors.As(err, &parseErr), input)
+	}
+
+	succeeds("2019", 2019, -1, "2019")
+	succeeds("2019Z", 2019, 0, "2019Z")
+	succeeds("2019+02:00", 2019, 7200, "2019+02:00")
+	succeeds("2019-05:30", 2019, -19800, "2019-05:30")
+	succeeds("2019-00:00", 2019, 0, "2019Z")
+	succeeds("2019+14:00", 2019, 50400, "2019+14:00")
+	succeeds("0044", 44, -1, "0044")
+	succeeds("-0044", -44, -1, "-0044")
+	succeeds("12019Z", 12019, 0, "12019Z")
+
+	fails("")
+	fails("Z")
+	fails("201")
+	fails("019Z")
+	fails("02019")
+	fails("+2019")
+	fails("-+201")
+	fails("20x9")
+	fails("2019z")
+	fails("2019 02:00")
+	fails("2019+2:00")
+	fails("2019+15:00")
+	fails("2019+14:30")
+	fails("2019+02:60")
+	fails("2019+02:00Z")
+}
+
+func (suite *XSDSuite) TestParseGYearMonth() {
+	succeeds := func(input string, year int, month time.Month, zone int, canonical string) {
+		value, err := isodates.ParseGYearMonth(input)
+		_ = suite.NoError(err, input) &&
+			suite.Equal(year, value.Year, input) &&
+			suite.Equal(month, value.Month, input) &&
+			suite.Equal(zone, offset(value.Location), input) &&
+			suite.Equal(canonical, value.String(), input)
+	}
+	fails := func(input string) {
+		_, err := isodates.ParseGYearMonth(input)
+		var parseErr *isodates.ParseError
+		suite.True(errors.As(err, &parseErr), input)
+	}
+
+	succeeds("2019-05", 2019, time.May, -1, "2019-05")
+	succeeds("2019-05Z", 2019, time.May, 0, "2019-05Z")
+	succeeds("2019-05+02:00", 2019, time.May, 7200, "2019-05+02:00")
+	succeeds("2019-05-04:00", 2019, time.May, -14400, "2019-05-04:00")
+	succeeds("-0044-03", -44, time.March, -1, "-0044-03")
+	succeeds("12019-12Z", 12019, time.December, 0, "12019-12Z")
+
+	fails("")
+	fails("2019")
+	fails("2019-5")
+	fails("2019-5Z")
+	fails("2019/05")
+	fails("2019-13")
+	fails("2019-00Z")
+	fails("19-05")
+	fails("2019-05+02")
+	fails("2019-05+02:0x")
+	fails("2019-+5")
+	fails("2019-05+-0:00")
+}
+
+func (suite *XSDSuite) TestParseGMonthDay() {
+	succeeds := func(input string, month time.Month, day int, zone int, canonical string) {
+		value, err := isodates.ParseGMonthDay(input)
+		_ = suite.NoError(err, input) &&
+			suite.Equal(month, value.Month, input) &&
+			suite.Equal(day, value.Day, input) &&
+			suite.Equal(zone, offset(value.Location), input) &&
+			suite.Equal(canonical, value.String(), input)
+	}
+	fails := func(input string, reason isodates.Reason) {
+		_, err := isodates.ParseGMonthDay(input)
+		var parseErr *isodates.ParseError
+		_ = suite.True(errors.As(err, &parseErr), input) &&
+			suite.Equal(string(reason), string(parseErr.Reason), input)
+	}
+
+	succeeds("--12-25", time.December, 25, -1, "--12-25")
+	succeeds("--12-25Z", time.December, 25, 0, "--12-25Z")
+	succeeds("--02-29-05:00", time.February, 29, -18000, "--02-29-05:00")
+
+	fails("", isodates.ReasonInvalidFormat)
+	fails("--12-2", isodates.ReasonInvalidFormat)
+	fails("--2-25", isodates.ReasonInvalidFormat)
+	fails("--12-25-", isodates.ReasonInvalidFormat)
+	fails("-+12-25", isodates.ReasonInvalidFormat)
+	fails("--12/25", isodates.ReasonInvalidFormat)
+	fails("--13-01Z", isodates.ReasonOutOfRange)
+	fails("--12-32", isodates.ReasonOutOfRange)
+	fails("--02-30", isodates.ReasonNonexistent)
+	fails("--04-31Z", isodates.ReasonNonexistent)
+	fails("--12-25+24:00", isodates.ReasonOutOfRange)
+	fails("--+1-25", isodates.ReasonNotNumeric)
+	fails("--12-+5", isodates.ReasonNotNumeric)
+	fails("--12-25+-0:00", isodates.ReasonNotNumeric)
+}
+
+func (suite *XSDSuite) TestParseGDay() {
+	succeeds := func(input string, day int, zone int, canonical string) {
+		value, err := isodates.ParseGDay(input)
+		_ = suite.NoError(err, input) &&
+			suite.Equal(day, value.Day, input) &&
+			suite.Equal(zone, offset(value.Location), input) &&
+			suite.Equal(canonical, value.String(), input)
+	}
+	fails := func(input string) {
+		_, err := isodates.ParseGDay(input)
+		var parseErr *isodates.ParseError
+		suite.True(errors.As(err, &parseErr), input)
+	}
+
+	succeeds("---15", 15, -1, "---15")
+	succeeds("---01Z", 1, 0, "---01Z")
+	succeeds("---31+09:30", 31, 34200, "---31+09:30")
+
+	fails("")
+	fails("---1")
+	fails("---015")
+	fails("--15")
+	fails("-+-15")
+	fails("---00")
+	fails("---32")
+	fails("---1x")
+	fails("---+5")
+	fails("---15+-0:00")
+}
+
+func (suite *XSDSuite) TestParseGMonth() {
+	succeeds := func(input string, month time.Month, zone int, canonical string) {
+		value, err := isodates.ParseGMonth(input)
+		_ = suite.NoError(err, input) &&
+			suite.Equal(month, value.Month, input) &&
+			suite.Equal(zone, offset(value.Location), input) &&
+			suite.Equal(canonical, value.String(), input)
+	}
+	fails := func(input string) {
+		_, err := isodates.ParseGMonth(input)
+		var parseErr *isodates.ParseError
+		suite.True(errors.As(err, &parseErr), input)
+	}
+
+	succeeds("--12", time.December, -1, "--12")
+	succeeds("--05Z", time.May, 0, "--05Z")
+	succeeds("--05-03:00", time.May, -10800, "--05-03:00")
+	succeeds("--05--", time.May, -1, "--05")
+	succeeds("--05--Z", time.May, 0, "--05Z")
+
+	fails("")
+	fails("--5")
+	fails("--005")
+	fails("-+05")
+	fails("--00")
+	fails("--13Z")
+	fails("--05-")
+	fails("--05-+02:00")
+	fails("--+1")
+	fails("--05+-0:00")
+}
+
+func (suite *XSDSuite) TestErrors() {
+	_, err := isodates.ParseGYearMonth("2019-05+15:00")
+	suite.EqualError(err, "invalid time zone: 15")
+
+	var parseErr *isodates.ParseError
+	_, err = isodates.ParseGMonthDay("--12-25+02:60")
+	_ = suite.True(errors.As(err, &parseErr)) &&
+		suite.Equal("--MM-DD[Z|±hh:mm]", parseErr.Format) &&
+		suite.Equal(string(isodates.ComponentTimeZone), string(parseErr.Component)) &&
+		suite.Equal("60", parseErr.Value) &&
+		suite.Equal(11, parseErr.Offset)
+}
+
+func (suite *XSDSuite) TestStartEnd() {
+	plus2 := time.FixedZone("+02:00", 7200)
+
+	// With a time zone, the value uses its own offset regardless of the location you pass in.
+	year, _ := isodates.ParseGYear("2019+02:00")
+	suite.AssertMidnightIn(year.Start(locationEDT), nil, 2019, time.January, 1, plus2)
+	suite.AssertAlmostMidnightIn(year.End(locationEDT), nil, 2019, time.December, 31, plus2)
+
+	// Without one, it's in the location you pass in.
+	year, _ = isodates.ParseGYear("2019")
+	suite.AssertMidnightIn(year.Start(locationEDT), nil, 2019, time.January, 1, locationEDT)
+	suite.AssertAlmostMidnightIn(year.End(time.UTC), nil, 2019, time.December, 31, time.UTC)
+
+	yearMonth, _ := isodates.ParseGYearMonth("2019-02Z")
+	suite.AssertMidnightIn(yearMonth.Start(locationEDT), nil, 2019, time.February, 1, time.UTC)
+	suite.AssertAlmostMidnightIn(yearMonth.End(locationEDT), nil, 2019, time.February, 28, time.UTC)
+	suite.True(yearMonth.Range(locationEDT).Equal(days(2019, time.February, 1, 2019, time.February, 28)))
+
+	monthDay, _ := isodates.ParseGMonthDay("--12-25+02:00")
+	suite.AssertMidnightIn(monthDay.Start(2019, time.UTC), nil, 2019, time.December, 25, plus2)
+	suite.AssertAlmostMidnightIn(monthDay.End(2019, time.UTC), nil, 2019, time.December, 25, plus2)
+	monthDay, _ = isodates.ParseGMonthDay("--02-29")
+	suite.AssertMidnightIn(monthDay.Start(2019, locationEDT), nil, 2019, time.March, 1, locationEDT)
+	suite.True(monthDay.Range(2020, time.UTC).Equal(day(2020, time.February, 29)))
+
+	gDay, _ := isodates.ParseGDay("---31Z")
+	suite.AssertMidnightIn(gDay.Start(2019, time.May, locationEDT), nil, 2019, time.May, 31, time.UTC)
+	suite.AssertAlmostMidnightIn(gDay.End(2019, time.May, locationEDT), nil, 2019, time.May, 31, time.UTC)
+	suite.AssertMidnightIn(gDay.Start(2019, time.April, locationEDT), nil, 2019, time.May, 1, time.UTC)
+	suite.True(gDay.Range(2019, time.May, nil).Equal(day(2019, time.May, 31)))
+
+	month, _ := isodates.ParseGMonth("--02")
+	suite.AssertMidnightIn(month.Start(2020, locationEDT), nil, 2020, time.February, 1, locationEDT)
+	suite.AssertAlmostMidnightIn(month.End(2020, locationEDT), nil, 2020, time.February, 29, locationEDT)
+	suite.True(month.Range(2019, time.UTC).Equal(days(2019, time.February, 1, 2019, time.February, 28)))
+}
+
+func ExampleParseGMonthDay() {
+	christmas, _ := isodates.ParseGMonthDay("--12-25+02:00")
+	fmt.Println(christmas)
+	fmt.Println(christmas.Start(2019, time.UTC))
+
+	// Output:
+	// --12-25+02:00
+	// 2019-12-25 00:00:00 +0200 +02:00
+}
@@ -1,56 +1,55 @@
 package isodates
 
 import (
-	"errors"
+	"fmt"
 	"time"
 )
 
 // ParseYearMonth accepts an ISO string such as "2019-04" and returns the individual date
 // components for the year and month (e.g. 2019 and time.April). We also support the variant
-// where you can prefix the year with either "+" or "-".
+// where you can prefix the year with either "+" or "-", including expanded years with more
+// than 4 digits (e.g. "+10000-01" or "+002019-04").
 func ParseYearMonth(input string) (int, time.Month, error) {
 	inputLength := len(input)
-	yearText := ""
-	monthText := ""
+	format := "[+-]YYYY-MM"
+	yearEnd := 4
+
+	switch {
+	// Expanded years such as "+10000-01" or "+002019-04"
+	case inputLength > 8 && (input[0] == '+' || input[0] == '-'):
+		yearEnd = inputLength - 3
+		if err := checkLiteral(format, input, yearEnd, "-"); err != nil {
+			return 0, ZeroMonth, err
+		}
 
 	// Must either by "YYYY-MM", "+YYYY-MM", or "-YYYY-MM"
-	if inputLength < 7 || inputLength > 8 {
-		return 0, ZeroMonth, invalidFormat("[+-]YYYY-MM", input)
-	}
+	case inputLength < 7 || inputLength > 8:
+		return 0, ZeroMonth, checkLength(format, input, 8)
 
 	// Either "+YYYY-MM" or "-YYYY-MM"
-	if inputLength == 8 {
-		if input[5] != '-' {
-			return 0, ZeroMonth, invalidFormat("[+-]YYYY-MM", input)
+	case inputLength == 8:
+		if err := checkLiteral(format, input, 5, "-"); err != nil {
+			return 0, ZeroMonth, err
 		}
-
 		// For 8-character variant, the first character must be '+' or '-'
-		switch input[0] {
-		case '+':
-			yearText = input[1:5]
-			monthText = input[6:]
-		case '-':
-			yearText = input[0:5]
-			monthText = input[6:]
-		default:
-			return 0, ZeroMonth, invalidFormat("[+-]YYYY-MM", input)
+		if input[0] != '+' && input[0] != '-' {
+			return 0, ZeroMonth, formatError(format, input, 0)
 		}
-	}
+		yearEnd = 5
 
 	// "YYYY-MM" format
-	if inputLength == 7 {
-		if input[4] != '-' {
-			return 0, ZeroMonth, invalidFormat("YYYY-MM", input)
+	default:
+		format = "YYYY-MM"
+		if err := checkLiteral(format, input, 4, "-"); err != nil {
+			return 0, ZeroMonth, err
 		}
-		yearText = input[0:4]
-		monthText = input[5:]
 	}
 
-	year, err := parseYear(yearText)
+	year, err := yearLayout{format: format, yearEnd: yearEnd}.parseYear(input)
 	if err != nil {
 		return 0, ZeroMonth, err
 	}
-	month, err := parseMonth(monthText)
+	month, err := parseMonth(format, input, yearEnd+1, inputLength)
 	if err != nil {
 		return 0, ZeroMonth, err
 	}
@@ -67,13 +66,13 @@ func ParseYearMonthStart(input string) (time.Time, error) {
 // resulting date will be at midnight in the specified time zone.
 func ParseYearMonthStartIn(input string, loc *time.Location) (time.Time, error) {
 	if loc == nil {
-		return ZeroTime, errors.New("parse year month start: nil location")
+		return ZeroTime, fmt.Errorf("parse year month start: %w", ErrNilLocation)
 	}
-	year, month, err := ParseYearMonth(input)
+	yearMonth, err := ParseYearMonthValue(input)
 	if err != nil {
 		return ZeroTime, err
 	}
-	return Midnight(year, month, 1, loc), nil
+	return yearMonth.Start(loc), nil
 }
 
 // ParseYearMonthEnd returns the last day of the year/month for the parsed input. The
@@ -86,12 +85,42 @@ func ParseYearMonthEnd(input string) (time.Time, error) {
 // resulting date will be at 11:59:59pm in the specified time zone.
 func ParseYearMonthEndIn(input string, loc *time.Location) (time.Time, error) {
 	if loc == nil {
-		return ZeroTime, errors.New("parse year month end: nil location")
+		return ZeroTime, fmt.Errorf("parse year month end: %w", ErrNilLocation)
 	}
-	year, month, err := ParseYearMonth(input)
+	yearMonth, err := ParseYearMonthValue(input)
 	if err != nil {
 		return ZeroTime, err
 	}
-	firstOfMonth := AlmostMidnight(year, month, 1, loc)
-	return firstOfMonth.AddDate(0, 1, -1), nil
+	return yearMonth.End(loc), nil
+}
+
+// ParseYearMonthEndExclusive returns the first day of the month after the parsed year/month, which
+// is the exclusive end of the month. The resulting date will be at midnight in UTC.
+func ParseYearMonthEndExclusive(input string) (time.Time, error) {
+	return ParseYearMonthEndExclusiveIn(input, time.UTC)
+}
+
+// ParseYearMonthEndExclusiveIn returns the first day of the month after the parsed year/month. The
+// resulting date will be at midnight in the specified time zone.
+func ParseYearMonthEndExclusiveIn(input string, loc *time.Location) (time.Time, error) {
+	return exclusiveEnd(ParseYearMonthEndIn(input, loc))
+}
+
+// ParseYearMonthRange returns the range from midnight on the first day through 11:59:59pm on the
+// last day of the year/month for the parsed input. The resulting range will be in UTC.
+func ParseYearMonthRange(input string) (Range, error) {
+	return ParseYearMonthRangeIn(input, time.UTC)
+}
+
+// ParseYearMonthRangeIn returns the range from midnight on the first day through 11:59:59pm on the
+// last day of the year/month for the parsed input. The resulting range will be in the specified time zone.
+func ParseYearMonthRangeIn(input string, loc *time.Location) (Range, error) {
+	if loc == nil {
+		return Range{}, fmt.Errorf("parse year month range: %w", ErrNilLocation)
+	}
+	yearMonth, err := ParseYearMonthValue(input)
+	if err != nil {
+		return Range{}, err
+	}
+	return yearMonth.Range(loc), nil
 }
@@ -52,6 +52,9 @@ func (suite *YearMonthSuite) TestParseYearMonth() {
 	fails("2019-01-03") // Good ISO date. Not a good ISO year/month.
 	fails("2019-xx")    // unable to parse number
 	fails("xxxx-03")    // unable to parse number
+	fails("+-2019-01")  // only one sign
+	fails("+002019/01") // expanded years still need the separator
+	fails("+00x019-01") // unable to parse number
 
 	succeeds("2000-01", 2000, time.January)
 	succeeds("2000-11", 2000, time.November)
@@ -85,6 +88,10 @@ func (suite *YearMonthSuite) TestParseYearMonth() {
 	succeeds("-0001-12", -1, time.December)
 	succeeds("-0001-01", -1, time.January)
 	succeeds("-0000-01", 0, time.January)
+
+	succeeds("+10000-01", 10000, time.January)
+	succeeds("+002019-05", 2019, time.May)
+	succeeds("-000044-03", -44, time.March)
 }
 
 func (suite *YearMonthSuite) TestParseYearMonthStart() {
@@ -191,6 +198,49 @@ func (suite *YearMonthSuite) TestParseYearMonthEndIn() {
 	succeeds("2013-12", 2013, time.December, 31, locationPDT)
 }
 
+func (suite *YearMonthSuite) TestParseYearMonthEndExclusiveIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, day int) {
+		date, err := isodates.ParseYearMonthEndExclusiveIn(input, loc)
+		suite.AssertMidnightIn(date, err, year, month, day, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.ParseYearMonthEndExclusiveIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", time.UTC)
+	fails("2019-13", time.UTC)
+	fails("2019-05", nil)
+
+	succeeds("2019-05", time.UTC, 2019, time.June, 1)
+	succeeds("2020-02", locationEDT, 2020, time.March, 1)
+	succeeds("2019-12", locationPDT, 2020, time.January, 1)
+
+	date, err := isodates.ParseYearMonthEndExclusive("2019-02")
+	suite.AssertMidnightUTC(date, err, 2019, time.March, 1)
+}
+
+func (suite *YearMonthSuite) TestParseYearMonthRangeIn() {
+	succeeds := func(input string, loc *time.Location, year int, month time.Month, lastDay int) {
+		r, err := isodates.ParseYearMonthRangeIn(input, loc)
+		suite.AssertRangeIn(r, err, year, month, 1, year, month, lastDay, loc)
+	}
+	fails := func(input string, loc *time.Location) {
+		_, err := isodates.ParseYearMonthRangeIn(input, loc)
+		suite.Error(err)
+	}
+	fails("", time.UTC)
+	fails("2019-13", time.UTC)
+	fails("2019-05", nil)
+
+	succeeds("2019-05", time.UTC, 2019, time.May, 31)
+	succeeds("2019-02", locationEDT, 2019, time.February, 28)
+	succeeds("2020-02", locationPDT, 2020, time.February, 29)
+	succeeds("2019-12", locationEDT, 2019, time.December, 31)
+
+	r, err := isodates.ParseYearMonthRange("2019-04")
+	suite.AssertRangeIn(r, err, 2019, time.April, 1, 2019, time.April, 30, time.UTC)
+}
+
 func ExampleParseYearMonth() {
 	year, month, err := isodates.ParseYearMonth("2019-01")
 	fmt.Println(fmt.Sprintf("%d %d %v", year, month, err == nil))